@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"route256/cart/internal/app"
 	"route256/cart/pkg/logger"
@@ -18,6 +19,22 @@ const (
 	defaultProductAddr = "http://route256.pavl.uk:8080"
 	defaultLOMSAddr    = "loms:50051"
 	defaultJaegerAddr  = "http://jaeger:4318"
+	defaultStorage     = app.StorageMemory
+	defaultCartTTL     = 24 * time.Hour
+	defaultSavedTTL    = 30 * 24 * time.Hour
+	defaultCartSweep   = time.Minute
+	defaultCartShards  = 32
+	defaultSnapshot    = time.Minute
+	defaultCacheSize   = 10000
+	defaultCacheTTL    = 5 * time.Minute
+	defaultBreakerFail = 5
+	defaultBreakerOpen = 10 * time.Second
+	defaultLOMSTimeout = time.Second
+	defaultLOMSRetries = 2
+	defaultIdempotency = 24 * time.Hour
+	defaultRetries     = 2
+	defaultRetryBase   = 100 * time.Millisecond
+	defaultRetryMax    = 5 * time.Second
 
 	productToken = "testtoken"
 )
@@ -47,8 +64,30 @@ func initOpts() app.Options {
 	flag.StringVar(&options.Addr, "addr", defaultAddr, fmt.Sprintf("server address, default: %q", defaultAddr))
 	flag.StringVar(&options.ProductAddr, "product_addr", defaultProductAddr, fmt.Sprintf("products-service address, default: %q", defaultProductAddr))
 	flag.StringVar(&options.LOMSAddr, "loms_addr", defaultLOMSAddr, fmt.Sprintf("loms-service address, default: %q", defaultLOMSAddr))
+	flag.DurationVar(&options.LOMSTimeout, "loms_timeout", defaultLOMSTimeout, fmt.Sprintf("loms-service call deadline including retries, default: %s", defaultLOMSTimeout))
+	flag.IntVar(&options.LOMSRetries, "loms_retries", defaultLOMSRetries, fmt.Sprintf("loms-service retries of idempotent calls, default: %d", defaultLOMSRetries))
+	flag.IntVar(&options.LOMSBreakerFailures, "loms_breaker_failures", defaultBreakerFail, fmt.Sprintf("consecutive loms-service failures opening the circuit breaker, 0 disables breaker, default: %d", defaultBreakerFail))
+	flag.DurationVar(&options.LOMSBreakerTimeout, "loms_breaker_timeout", defaultBreakerOpen, fmt.Sprintf("loms-service circuit breaker open state duration, default: %s", defaultBreakerOpen))
 	flag.StringVar(&options.JaegerAddr, "jaeger_addr", defaultJaegerAddr, fmt.Sprintf("jaeger address, default: %q", defaultJaegerAddr))
+	flag.StringVar(&options.ProductCatalog, "product_catalog", "", "products JSON file used instead of products-service, empty uses products-service")
 	flag.StringVar(&options.ProductToken, "product_token", productToken, "products-service token")
+	flag.IntVar(&options.ProductCacheSize, "product_cache_size", defaultCacheSize, fmt.Sprintf("products cache capacity, 0 disables cache, default: %d", defaultCacheSize))
+	flag.DurationVar(&options.ProductCacheTTL, "product_cache_ttl", defaultCacheTTL, fmt.Sprintf("products cache entry lifetime, default: %s", defaultCacheTTL))
+	flag.IntVar(&options.ProductBreakerFailures, "product_breaker_failures", defaultBreakerFail, fmt.Sprintf("consecutive products-service failures opening the circuit breaker, 0 disables breaker, default: %d", defaultBreakerFail))
+	flag.DurationVar(&options.ProductBreakerTimeout, "product_breaker_timeout", defaultBreakerOpen, fmt.Sprintf("products-service circuit breaker open state duration, default: %s", defaultBreakerOpen))
+	flag.IntVar(&options.ProductRetries, "product_retries", defaultRetries, fmt.Sprintf("products-service retries after the first attempt, default: %d", defaultRetries))
+	flag.DurationVar(&options.ProductRetryBaseDelay, "product_retry_base_delay", defaultRetryBase, fmt.Sprintf("products-service delay before the first retry, doubles with every next one, default: %s", defaultRetryBase))
+	flag.DurationVar(&options.ProductRetryMaxDelay, "product_retry_max_delay", defaultRetryMax, fmt.Sprintf("products-service max delay between retries including Retry-After, default: %s", defaultRetryMax))
+	flag.DurationVar(&options.CheckoutIdempotencyTTL, "checkout_idempotency_ttl", defaultIdempotency, fmt.Sprintf("lifetime of checkout Idempotency-Key replays, default: %s", defaultIdempotency))
+	flag.StringVar(&options.Storage, "storage", defaultStorage, fmt.Sprintf("cart storage type (%q, %q or %q), default: %q", app.StorageMemory, app.StorageMemorySharded, app.StoragePostgres, defaultStorage))
+	flag.StringVar(&options.DBConn, "db_conn", "", "cart database connection string, required for postgres storage")
+	flag.DurationVar(&options.CartTTL, "cart_ttl", defaultCartTTL, fmt.Sprintf("%q storage cart lifetime after the last change, 0 disables expiration, default: %s", app.StorageMemory, defaultCartTTL))
+	flag.IntVar(&options.CartShards, "cart_shards", defaultCartShards, fmt.Sprintf("number of buckets of the %q storage, default: %d", app.StorageMemorySharded, defaultCartShards))
+	flag.DurationVar(&options.CartSweepInterval, "cart_sweep_interval", defaultCartSweep, fmt.Sprintf("in-memory expired carts sweep interval, default: %s", defaultCartSweep))
+	flag.StringVar(&options.SnapshotPath, "snapshot_path", "", fmt.Sprintf("%q storage snapshot file, empty disables snapshots", app.StorageMemory))
+	flag.DurationVar(&options.SavedTTL, "saved_ttl", defaultSavedTTL, fmt.Sprintf("%q storage saved for later list lifetime after the last change, 0 disables expiration, default: %s", app.StorageMemory, defaultSavedTTL))
+	flag.StringVar(&options.SavedSnapshotPath, "saved_snapshot_path", "", fmt.Sprintf("%q storage saved for later snapshot file, empty disables snapshots", app.StorageMemory))
+	flag.DurationVar(&options.SnapshotInterval, "snapshot_interval", defaultSnapshot, fmt.Sprintf("%q storage snapshot interval, default: %s", app.StorageMemory, defaultSnapshot))
 	flag.Parse()
 
 	return options
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"route256/cart/internal/clients/product/catalog"
+)
+
+const (
+	defaultAddr    = ":8080"
+	defaultCatalog = "e2e/testdata/products.json"
+	defaultToken   = "testtoken"
+	defaultRPS     = 10
+	defaultBurst   = 10
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	addr := flag.String("addr", defaultAddr, fmt.Sprintf("server address, default: %q", defaultAddr))
+	catalogPath := flag.String("catalog", defaultCatalog, fmt.Sprintf("products JSON file, default: %q", defaultCatalog))
+	token := flag.String("token", defaultToken, "expected products-service token")
+	rps := flag.Float64("rps", defaultRPS, fmt.Sprintf("requests per second before 420 responses, default: %d", defaultRPS))
+	burst := flag.Int("burst", defaultBurst, fmt.Sprintf("rate limiter burst, default: %d", defaultBurst))
+	flag.Parse()
+
+	products, err := catalog.Load(*catalogPath)
+	if err != nil {
+		log.Fatalf("failed to load catalog: %v", err)
+	}
+
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           newServer(products, *token, rate.NewLimiter(rate.Limit(*rps), *burst)).routes(),
+		ReadHeaderTimeout: 3 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_ = httpServer.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("product stub is listening on %s", *addr)
+
+	if err = httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("error starting server: %v", err)
+	}
+}
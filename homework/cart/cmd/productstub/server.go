@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"golang.org/x/time/rate"
+
+	"route256/cart/internal/clients/product"
+	"route256/cart/internal/domain"
+)
+
+const enhanceYourCalmStatus = 420
+
+type (
+	productCatalog interface {
+		GetProductInfo(ctx context.Context, sku uint32) (*domain.Product, error)
+		GetProductsInfo(ctx context.Context, skus []uint32) (map[uint32]*domain.Product, error)
+	}
+
+	// server implements the get_product and get_products contract of the product service.
+	server struct {
+		catalog productCatalog
+		token   string
+		limiter *rate.Limiter
+	}
+)
+
+func newServer(catalog productCatalog, token string, limiter *rate.Limiter) *server {
+	return &server{
+		catalog: catalog,
+		token:   token,
+		limiter: limiter,
+	}
+}
+
+func (s *server) routes() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /get_product", s.getProduct)
+	mux.HandleFunc("POST /get_products", s.getProducts)
+
+	return mux
+}
+
+func (s *server) getProduct(w http.ResponseWriter, r *http.Request) {
+	request := product.GetProductRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	if !s.allow(w, request.Token) {
+		return
+	}
+
+	p, err := s.catalog.GetProductInfo(r.Context(), request.SKU)
+	if err != nil {
+		if errors.Is(err, product.ErrProductNotFound) {
+			writeError(w, http.StatusNotFound, "sku not found")
+			return
+		}
+
+		writeError(w, http.StatusInternalServerError, err.Error())
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, product.GetProductResponse{
+		Name:  p.Name,
+		Price: p.Price,
+	})
+}
+
+func (s *server) getProducts(w http.ResponseWriter, r *http.Request) {
+	request := product.GetProductsRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	if !s.allow(w, request.Token) {
+		return
+	}
+
+	products, err := s.catalog.GetProductsInfo(r.Context(), request.SKUs)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := product.GetProductsResponse{
+		Products: make([]product.GetProductsResponseItem, 0, len(products)),
+	}
+
+	// Порядок ответа совпадает с порядком запроса, неизвестные sku пропускаются
+	for _, sku := range request.SKUs {
+		if p, ok := products[sku]; ok {
+			response.Products = append(response.Products, product.GetProductsResponseItem{
+				SKU:   sku,
+				Name:  p.Name,
+				Price: p.Price,
+			})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// allow checks the token and the rate limit, the error response is already written when it returns false.
+func (s *server) allow(w http.ResponseWriter, token string) bool {
+	if token != s.token {
+		writeError(w, http.StatusUnauthorized, "invalid token")
+		return false
+	}
+
+	if !s.limiter.Allow() {
+		writeError(w, enhanceYourCalmStatus, "too many requests")
+		return false
+	}
+
+	return true
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, product.GetProductErrorResponse{
+		Code:    statusCode,
+		Message: message,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
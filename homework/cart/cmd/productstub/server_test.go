@@ -0,0 +1,122 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"route256/cart/internal/clients/product"
+	"route256/cart/internal/domain"
+)
+
+type fakeCatalog map[uint32]domain.Product
+
+func (c fakeCatalog) GetProductInfo(_ context.Context, sku uint32) (*domain.Product, error) {
+	p, ok := c[sku]
+	if !ok {
+		return nil, product.ErrProductNotFound
+	}
+
+	return &p, nil
+}
+
+func (c fakeCatalog) GetProductsInfo(_ context.Context, skus []uint32) (map[uint32]*domain.Product, error) {
+	products := make(map[uint32]*domain.Product, len(skus))
+	for _, sku := range skus {
+		if p, ok := c[sku]; ok {
+			products[sku] = &p
+		}
+	}
+
+	return products, nil
+}
+
+func post(t *testing.T, handler http.Handler, path string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	data, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, path, bytes.NewBuffer(data)))
+
+	return recorder
+}
+
+func TestGetProductTable(t *testing.T) {
+	t.Parallel()
+
+	type data struct {
+		name       string
+		request    product.GetProductRequest
+		wantStatus int
+		wantBody   string
+	}
+
+	testData := []data{
+		{
+			name:       "success",
+			request:    product.GetProductRequest{Token: "token", SKU: 100},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"name":"Книга","price":300}`,
+		},
+		{
+			name:       "invalid token",
+			request:    product.GetProductRequest{Token: "wrong", SKU: 100},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "unknown sku",
+			request:    product.GetProductRequest{Token: "token", SKU: 200},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newServer(fakeCatalog{100: {Name: "Книга", Price: 300}}, "token", rate.NewLimiter(rate.Inf, 0))
+
+			recorder := post(t, s.routes(), "/get_product", tt.request)
+			require.Equal(t, tt.wantStatus, recorder.Code)
+
+			if tt.wantBody != "" {
+				require.JSONEq(t, tt.wantBody, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetProductRateLimit(t *testing.T) {
+	t.Parallel()
+
+	s := newServer(fakeCatalog{100: {Name: "Книга", Price: 300}}, "token", rate.NewLimiter(rate.Limit(1), 1))
+	request := product.GetProductRequest{Token: "token", SKU: 100}
+
+	require.Equal(t, http.StatusOK, post(t, s.routes(), "/get_product", request).Code)
+	require.Equal(t, enhanceYourCalmStatus, post(t, s.routes(), "/get_product", request).Code)
+}
+
+func TestGetProducts(t *testing.T) {
+	t.Parallel()
+
+	s := newServer(fakeCatalog{
+		100: {Name: "Книга", Price: 300},
+		200: {Name: "Ручка", Price: 50},
+	}, "token", rate.NewLimiter(rate.Inf, 0))
+
+	recorder := post(t, s.routes(), "/get_products", product.GetProductsRequest{Token: "token", SKUs: []uint32{200, 300, 100}})
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.JSONEq(t, `{"products":[
+		{"sku":200,"name":"Ручка","price":50},
+		{"sku":100,"name":"Книга","price":300}
+	]}`, recorder.Body.String())
+}
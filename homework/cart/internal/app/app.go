@@ -6,30 +6,57 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/pprof"
+	"os"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	otelResource "go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"golang.org/x/time/rate"
 
 	"route256/cart/internal/app/closer"
 	appHttp "route256/cart/internal/app/http"
 	"route256/cart/internal/app/middleware"
 	"route256/cart/internal/clients/loms"
 	"route256/cart/internal/clients/product"
+	productBreaker "route256/cart/internal/clients/product/breaker"
+	productCache "route256/cart/internal/clients/product/cache"
+	productCatalog "route256/cart/internal/clients/product/catalog"
 	"route256/cart/internal/domain"
+	"route256/cart/internal/repository/db/cartitems"
+	"route256/cart/internal/repository/db/saveditems"
+	"route256/cart/internal/repository/db/transaction"
+	"route256/cart/internal/repository/idempotencyrepo"
 	"route256/cart/internal/repository/memorycartrepo"
 	cartCheckout "route256/cart/internal/service/cart/checkout"
 	cartDelete "route256/cart/internal/service/cart/delete"
 	cartItemAdd "route256/cart/internal/service/cart/item/add"
+	cartItemAddBatch "route256/cart/internal/service/cart/item/addbatch"
 	cartItemDelete "route256/cart/internal/service/cart/item/delete"
+	cartItemSet "route256/cart/internal/service/cart/item/set"
 	cartList "route256/cart/internal/service/cart/list"
+	cartMerge "route256/cart/internal/service/cart/merge"
+	cartPreview "route256/cart/internal/service/cart/preview"
+	savedRestore "route256/cart/internal/service/cart/saved/restore"
+	savedSave "route256/cart/internal/service/cart/saved/save"
 	"route256/cart/pkg/logger"
 )
 
+const (
+	// checkoutIdempotencyKeys bounds the number of remembered checkout idempotency keys
+	checkoutIdempotencyKeys = 100000
+
+	// productRequestsPerSecond is the rate of the product service requests
+	productRequestsPerSecond = 10
+
+	// lomsRequestsPerSecond is the rate of the per-item stocks requests to loms
+	lomsRequestsPerSecond = 100
+)
+
 type (
 	mux interface {
 		Handle(pattern string, handler http.Handler)
@@ -42,29 +69,62 @@ type (
 	}
 
 	cartStorage interface {
-		Add(_ context.Context, userID int64, item domain.Item)
-		DeleteOne(_ context.Context, userID, skuID int64)
-		DeleteAll(_ context.Context, userID int64)
+		Add(_ context.Context, userID int64, item domain.Item) error
+		Set(_ context.Context, userID int64, item domain.Item) error
+		DeleteOne(_ context.Context, userID, skuID int64) error
+		DeleteAll(_ context.Context, userID int64) error
 		GetAll(_ context.Context, userID int64) ([]domain.Item, error)
+		GetCount(_ context.Context, userID, skuID int64) (uint16, error)
+	}
+
+	savedStorage interface {
+		Add(_ context.Context, userID int64, item domain.Item) error
+		DeleteOne(_ context.Context, userID, skuID int64) error
+		GetAll(_ context.Context, userID int64) ([]domain.Item, error)
+		GetCount(_ context.Context, userID, skuID int64) (uint16, error)
+	}
+
+	txManager interface {
+		RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	// storages are the active carts and the saved for later items on the configured backend.
+	storages struct {
+		cart  cartStorage
+		saved savedStorage
+		tx    txManager
+		close closer.Func
 	}
 
 	productClient interface {
 		GetProductInfo(ctx context.Context, sku uint32) (*domain.Product, error)
+		GetProductsInfo(ctx context.Context, skus []uint32) (map[uint32]*domain.Product, error)
 	}
 
 	lomsClient interface {
-		CreateOrder(ctx context.Context, userID int64, items []domain.Item) (int, error)
+		CreateOrder(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) (int, error)
+		InfoOrder(ctx context.Context, orderID int) (*domain.Order, error)
 		InfoStocks(ctx context.Context, SKU int64) (int, error)
 	}
 
+	idempotencyStorage interface {
+		Get(_ context.Context, key string) (domain.OrderSummary, bool)
+		Set(_ context.Context, key string, summary domain.OrderSummary)
+	}
+
 	App struct {
 		ctx           context.Context
 		config        *Config
 		mux           mux
 		server        server
 		storage       cartStorage
+		savedStorage  savedStorage
+		txManager     txManager
+		storageClose  closer.Func
 		products      productClient
 		lomsClient    lomsClient
+		idempotency   idempotencyStorage
+		lomsLimiter   *rate.Limiter
 		closer        *closer.Closer
 		traceProvider *trace.TracerProvider
 	}
@@ -110,16 +170,36 @@ func NewApp(ctx context.Context, config *Config) (*App, error) {
 
 	otel.SetTracerProvider(traceProvider)
 
-	newProductsClient, err := product.New(config.productAddr, config.productToken)
+	// Лимиты product и loms раздельные, чтобы запросы остатков не ждали в очереди к product.
+	// lomsLimiter один на приложение и общий для всех хэндлеров, проверяющих остатки по товарам
+	productLimiter := rate.NewLimiter(productRequestsPerSecond, 1)
+	lomsLimiter := rate.NewLimiter(lomsRequestsPerSecond, 1)
+
+	newProductsClient, err := newProductClient(config, productLimiter)
 	if err != nil {
 		return nil, fmt.Errorf("the creation of a new product client failed: %w", err)
 	}
 
-	newLomsClient, err := loms.NewClient("user", config.lomsAddr)
+	newLomsClient, err := loms.NewClient("user", config.lomsAddr, loms.Options{
+		Timeout:         config.lomsTimeout,
+		MaxRetries:      config.lomsRetries,
+		BreakerFailures: uint32(config.lomsBreakerFailures),
+		BreakerTimeout:  config.lomsBreakerTimeout,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("the creation of a new loms client failed: %w", err)
 	}
 
+	storages, err := newCartStorage(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("the creation of a cart storage failed: %w", err)
+	}
+
+	idempotency, err := idempotencyrepo.NewMemoryStorage(checkoutIdempotencyKeys, config.checkoutIdempotencyTTL)
+	if err != nil {
+		return nil, fmt.Errorf("the creation of a checkout idempotency storage failed: %w", err)
+	}
+
 	return &App{
 		ctx:    ctx,
 		config: config,
@@ -129,20 +209,150 @@ func NewApp(ctx context.Context, config *Config) (*App, error) {
 			Handler:           middleware.Logging(mux),
 			ReadHeaderTimeout: 3 * time.Second,
 		},
-		storage:       memorycartrepo.NewMemoryStorage(),
+		storage:       storages.cart,
+		savedStorage:  storages.saved,
+		txManager:     storages.tx,
 		products:      newProductsClient,
 		lomsClient:    newLomsClient,
+		idempotency:   idempotency,
+		lomsLimiter:   lomsLimiter,
+		storageClose:  storages.close,
 		closer:        &closer.Closer{},
 		traceProvider: traceProvider,
 	}, nil
 }
 
+func newProductClient(config *Config, limiter *rate.Limiter) (productClient, error) {
+	// Локальный каталог заменяет внешний сервис целиком, кэш и breaker ему не нужны
+	if config.productCatalog != "" {
+		return productCatalog.Load(config.productCatalog)
+	}
+
+	productsClient, err := product.New(config.productAddr, config.productToken, product.Options{
+		Limiter:        limiter,
+		MaxRetries:     config.productRetries,
+		RetryBaseDelay: config.productRetryBaseDelay,
+		RetryMaxDelay:  config.productRetryMaxDelay,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var client productClient = productsClient
+
+	// Кэш оборачивает breaker, чтобы закэшированные товары отдавались и при недоступном сервисе
+	if config.productBreakerFailures > 0 {
+		client = productBreaker.New(client, uint32(config.productBreakerFailures), config.productBreakerTimeout)
+	}
+
+	if config.productCacheSize <= 0 {
+		return client, nil
+	}
+
+	return productCache.New(client, config.productCacheSize, config.productCacheTTL)
+}
+
+// newCartStorage creates storages of the active carts and of the saved for later items on the configured backend.
+func newCartStorage(ctx context.Context, config *Config) (*storages, error) {
+	switch config.storage {
+	case StorageMemory, "":
+		cart, cartClose, err := newMemoryStorage(config, config.snapshotPath, memorycartrepo.WithTTL(config.cartTTL))
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore carts from snapshot: %w", err)
+		}
+
+		saved, savedClose, err := newMemoryStorage(config, config.savedSnapshotPath,
+			memorycartrepo.WithTTL(config.savedTTL), memorycartrepo.WithSavedItemsMetrics())
+		if err != nil {
+			_ = cartClose(ctx)
+			return nil, fmt.Errorf("failed to restore saved items from snapshot: %w", err)
+		}
+
+		return &storages{
+			cart:  cart,
+			saved: saved,
+			tx:    memorycartrepo.TxManager{},
+			close: func(ctx context.Context) error {
+				return errors.Join(cartClose(ctx), savedClose(ctx))
+			},
+		}, nil
+	case StorageMemorySharded:
+		return &storages{
+			cart:  memorycartrepo.NewShardedMemoryStorage(config.cartShards),
+			saved: memorycartrepo.NewMemoryStorage(memorycartrepo.WithSavedItemsMetrics()),
+			tx:    memorycartrepo.TxManager{},
+			close: func(_ context.Context) error { return nil },
+		}, nil
+	case StoragePostgres:
+		pool, err := pgxpool.New(ctx, config.dbConn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create database pool: %w", err)
+		}
+
+		if err = pool.Ping(ctx); err != nil {
+			pool.Close()
+			return nil, fmt.Errorf("failed to connect to database: %w", err)
+		}
+
+		return &storages{
+			cart:  cartitems.NewStorage(pool),
+			saved: saveditems.NewStorage(pool),
+			tx:    transaction.NewManager(pool),
+			close: func(_ context.Context) error {
+				pool.Close()
+
+				return nil
+			},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unknown storage type %q", config.storage)
+}
+
+// newMemoryStorage creates a memory storage with the expiry sweeper and, if snapshotPath is set, restores it
+// from the snapshot and saves it there periodically and on close.
+func newMemoryStorage(config *Config, snapshotPath string, opts ...memorycartrepo.Option) (*memorycartrepo.MemoryStorage, closer.Func, error) {
+	storage := memorycartrepo.NewMemoryStorage(opts...)
+
+	if snapshotPath == "" {
+		go storage.RunExpirySweeper(config.cartSweepInterval)
+
+		return storage, storage.Stop, nil
+	}
+
+	err := storage.LoadSnapshot(snapshotPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, nil, err
+	}
+
+	go storage.RunExpirySweeper(config.cartSweepInterval)
+	go storage.RunSnapshotter(snapshotPath, config.snapshotInterval)
+
+	return storage, func(ctx context.Context) error {
+		_ = storage.Stop(ctx)
+
+		// Финальный снимок сохраняем после остановки фоновых задач, чтобы его не перезаписал snapshotter
+		if err := storage.SaveSnapshot(snapshotPath); err != nil {
+			return fmt.Errorf("failed to save final snapshot %q: %w", snapshotPath, err)
+		}
+
+		return nil
+	}, nil
+}
+
 func (a *App) ListenAndServe() error {
 	a.mux.Handle(a.config.path.cartItemAdd, appHttp.NewAddItemHandler(cartItemAdd.New(a.storage, a.products, a.lomsClient), a.config.path.cartItemAdd))
+	a.mux.Handle(a.config.path.cartItemsAdd, appHttp.NewAddItemsHandler(cartItemAddBatch.New(a.storage, a.products, a.lomsClient, a.lomsLimiter, a.txManager), a.config.path.cartItemsAdd))
+	a.mux.Handle(a.config.path.cartItemSet, appHttp.NewSetItemHandler(cartItemSet.New(a.storage, a.products, a.lomsClient), a.config.path.cartItemSet))
 	a.mux.Handle(a.config.path.cartItemDelete, appHttp.NewDeleteItemHandler(cartItemDelete.New(a.storage), a.config.path.cartItemDelete))
 	a.mux.Handle(a.config.path.cartDelete, appHttp.NewClearCartItemsHandler(cartDelete.New(a.storage), a.config.path.cartDelete))
-	a.mux.Handle(a.config.path.cartList, appHttp.NewGetCartItemsHandler(cartList.New(a.storage, a.products), a.config.path.cartList))
-	a.mux.Handle(a.config.path.cartCheckout, appHttp.NewCartCheckoutHandler(cartCheckout.New(a.storage, a.lomsClient), a.config.path.cartCheckout))
+	a.mux.Handle(a.config.path.cartList, appHttp.NewGetCartItemsHandler(cartList.New(a.storage, a.products, a.lomsClient, a.lomsLimiter), a.config.path.cartList))
+	a.mux.Handle(a.config.path.savedList, appHttp.NewGetSavedItemsHandler(cartList.New(a.savedStorage, a.products, a.lomsClient, a.lomsLimiter), a.config.path.savedList))
+	a.mux.Handle(a.config.path.savedItemSave, appHttp.NewSaveItemHandler(savedSave.New(a.storage, a.savedStorage, a.txManager), a.config.path.savedItemSave))
+	a.mux.Handle(a.config.path.savedItemRestore, appHttp.NewRestoreItemHandler(savedRestore.New(a.storage, a.savedStorage, a.lomsClient, a.txManager), a.config.path.savedItemRestore))
+	a.mux.Handle(a.config.path.cartMerge, appHttp.NewMergeCartsHandler(cartMerge.New(a.storage, a.lomsClient, a.products, a.lomsLimiter, a.txManager), a.config.path.cartMerge))
+	a.mux.Handle(a.config.path.cartCheckout, appHttp.NewCartCheckoutHandler(cartCheckout.New(a.storage, a.products, a.lomsClient, a.idempotency), a.config.path.cartCheckout))
+	a.mux.Handle(a.config.path.cartPreview, appHttp.NewPreviewCheckoutHandler(cartPreview.New(a.storage, a.products, a.lomsClient, a.lomsLimiter), a.config.path.cartPreview))
 	a.mux.Handle(a.config.path.metrics, promhttp.Handler())
 	a.mux.Handle("/debug/pprof/", http.HandlerFunc(pprof.Index))
 	a.mux.Handle("/debug/pprof/cmdline", http.HandlerFunc(pprof.Cmdline))
@@ -152,6 +362,9 @@ func (a *App) ListenAndServe() error {
 
 	a.closer.Add(a.server.Shutdown)
 
+	// Хранилище закрываем только после остановки server, чтобы не оборвать обрабатываемые запросы
+	a.closer.Add(a.storageClose)
+
 	a.closer.Add(func(ctx context.Context) error {
 		err := a.traceProvider.Shutdown(ctx)
 		if err != nil {
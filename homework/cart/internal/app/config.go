@@ -2,29 +2,71 @@ package app
 
 import (
 	"fmt"
+	"time"
 
 	"route256/cart/internal/app/definitions"
 )
 
+const (
+	StorageMemory        = "memory"
+	StorageMemorySharded = "memory-sharded"
+	StoragePostgres      = "postgres"
+)
+
 type (
 	Options struct {
 		Addr, ProductToken, ProductAddr, LOMSAddr, JaegerAddr string
+		ProductCatalog                                        string
+		Storage, DBConn, SnapshotPath, SavedSnapshotPath      string
+		CartTTL, CartSweepInterval, SnapshotInterval          time.Duration
+		SavedTTL                                              time.Duration
+		CartShards, ProductCacheSize, ProductBreakerFailures  int
+		ProductCacheTTL, ProductBreakerTimeout                time.Duration
+		LOMSTimeout, LOMSBreakerTimeout                       time.Duration
+		LOMSRetries, LOMSBreakerFailures                      int
+		CheckoutIdempotencyTTL                                time.Duration
+		ProductRetries                                        int
+		ProductRetryBaseDelay, ProductRetryMaxDelay           time.Duration
 	}
 
 	configProductService struct {
 		productToken, productAddr string
+		productCatalog            string
+		productCacheSize          int
+		productCacheTTL           time.Duration
+		productBreakerFailures    int
+		productBreakerTimeout     time.Duration
+		productRetries            int
+		productRetryBaseDelay     time.Duration
+		productRetryMaxDelay      time.Duration
+	}
+
+	configLomsService struct {
+		lomsAddr                         string
+		lomsTimeout, lomsBreakerTimeout  time.Duration
+		lomsRetries, lomsBreakerFailures int
 	}
 
 	path struct {
-		cartItemAdd, cartItemDelete, cartDelete, cartList, cartCheckout, metrics string
+		cartItemAdd, cartItemsAdd, cartItemSet, cartItemDelete, cartDelete, cartList, cartMerge, cartCheckout, cartPreview, metrics string
+		savedList, savedItemSave, savedItemRestore                                                                                  string
+	}
+
+	configStorage struct {
+		storage, dbConn, snapshotPath, savedSnapshotPath       string
+		cartTTL, savedTTL, cartSweepInterval, snapshotInterval time.Duration
+		cartShards                                             int
 	}
 
 	Config struct {
 		addr string
 		configProductService
-		lomsAddr   string
+		configStorage
+		configLomsService
 		jaegerAddr string
 		path       path
+
+		checkoutIdempotencyTTL time.Duration
 	}
 )
 
@@ -32,18 +74,55 @@ func NewConfig(opts *Options) *Config {
 	return &Config{
 		addr: opts.Addr,
 		configProductService: configProductService{
-			productToken: opts.ProductToken,
-			productAddr:  opts.ProductAddr,
+			productToken:     opts.ProductToken,
+			productAddr:      opts.ProductAddr,
+			productCatalog:   opts.ProductCatalog,
+			productCacheSize: opts.ProductCacheSize,
+			productCacheTTL:  opts.ProductCacheTTL,
+
+			productBreakerFailures: opts.ProductBreakerFailures,
+			productBreakerTimeout:  opts.ProductBreakerTimeout,
+
+			productRetries:        opts.ProductRetries,
+			productRetryBaseDelay: opts.ProductRetryBaseDelay,
+			productRetryMaxDelay:  opts.ProductRetryMaxDelay,
+		},
+		configStorage: configStorage{
+			storage:           opts.Storage,
+			dbConn:            opts.DBConn,
+			cartTTL:           opts.CartTTL,
+			savedTTL:          opts.SavedTTL,
+			cartSweepInterval: opts.CartSweepInterval,
+			cartShards:        opts.CartShards,
+			snapshotPath:      opts.SnapshotPath,
+			savedSnapshotPath: opts.SavedSnapshotPath,
+			snapshotInterval:  opts.SnapshotInterval,
+		},
+		configLomsService: configLomsService{
+			lomsAddr:            opts.LOMSAddr,
+			lomsTimeout:         opts.LOMSTimeout,
+			lomsRetries:         opts.LOMSRetries,
+			lomsBreakerFailures: opts.LOMSBreakerFailures,
+			lomsBreakerTimeout:  opts.LOMSBreakerTimeout,
 		},
-		lomsAddr:   opts.LOMSAddr,
 		jaegerAddr: opts.JaegerAddr,
+
+		checkoutIdempotencyTTL: opts.CheckoutIdempotencyTTL,
 		path: path{
 			cartItemAdd:    fmt.Sprintf("POST /user/{%s}/cart/{%s}", definitions.ParamUserID, definitions.ParamSkuID),
+			cartItemsAdd:   fmt.Sprintf("POST /user/{%s}/cart/items", definitions.ParamUserID),
+			cartItemSet:    fmt.Sprintf("PUT /user/{%s}/cart/{%s}", definitions.ParamUserID, definitions.ParamSkuID),
 			cartItemDelete: fmt.Sprintf("DELETE /user/{%s}/cart/{%s}", definitions.ParamUserID, definitions.ParamSkuID),
 			cartDelete:     fmt.Sprintf("DELETE /user/{%s}/cart/", definitions.ParamUserID),
 			cartList:       fmt.Sprintf("GET /cart/{%s}/list/", definitions.ParamUserID),
+			cartMerge:      fmt.Sprintf("POST /cart/{%s}/merge/{%s}", definitions.ParamFromUserID, definitions.ParamToUserID),
 			cartCheckout:   "POST /cart/checkout",
+			cartPreview:    fmt.Sprintf("POST /cart/{%s}/checkout/preview", definitions.ParamUserID),
 			metrics:        "GET /metrics",
+
+			savedList:        fmt.Sprintf("GET /cart/{%s}/saved/", definitions.ParamUserID),
+			savedItemSave:    fmt.Sprintf("POST /user/{%s}/saved/{%s}", definitions.ParamUserID, definitions.ParamSkuID),
+			savedItemRestore: fmt.Sprintf("POST /user/{%s}/saved/{%s}/cart", definitions.ParamUserID, definitions.ParamSkuID),
 		},
 	}
 }
@@ -1,6 +1,10 @@
 package definitions
 
 const (
-	ParamSkuID  = "sku_id"
-	ParamUserID = "user_id"
+	ParamSkuID      = "sku_id"
+	ParamUserID     = "user_id"
+	ParamFromUserID = "from_user"
+	ParamToUserID   = "to_user"
 )
+
+const HeaderIdempotencyKey = "Idempotency-Key"
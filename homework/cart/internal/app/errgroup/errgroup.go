@@ -2,6 +2,7 @@ package errgroup
 
 import (
 	"context"
+	"fmt"
 	"sync"
 )
 
@@ -89,3 +90,23 @@ func (g *Group) Go(f func() error) {
 
 	goFunc()
 }
+
+// SetLimit limits the number of active goroutines in this group to at most n.
+// A negative value indicates no limit.
+//
+// Any subsequent call to the Go method will block until it can add an active
+// goroutine without exceeding the configured limit.
+//
+// The limit must not be modified while any goroutines in the group are active.
+func (g *Group) SetLimit(n int) {
+	if n < 0 {
+		g.sem = nil
+		return
+	}
+
+	if len(g.sem) != 0 {
+		panic(fmt.Errorf("errgroup: modify limit while %v goroutines in the group are still active", len(g.sem)))
+	}
+
+	g.sem = make(chan token, n)
+}
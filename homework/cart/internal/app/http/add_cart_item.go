@@ -101,6 +101,11 @@ func (h *AddItemHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					return
 				}
 
+				if statusCode, ok := productErrorStatusCode(err); ok {
+					GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), statusCode)
+					return
+				}
+
 				GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusInternalServerError)
 
 				return
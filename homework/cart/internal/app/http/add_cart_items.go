@@ -0,0 +1,173 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"gopkg.in/validator.v2"
+
+	"route256/cart/internal/app/definitions"
+	"route256/cart/internal/domain"
+	"route256/cart/internal/service/cart/item/addbatch"
+	"route256/cart/pkg/prometheus"
+)
+
+type AddCartItemsFailure struct {
+	SKU   int64  `json:"sku"`
+	Error string `json:"error"`
+}
+
+type AddCartItemsFailedResponse struct {
+	Failures []AddCartItemsFailure `json:"failures"`
+}
+
+type (
+	addItemsCommand interface {
+		AddItems(ctx context.Context, userID int64, items []domain.Item) error
+	}
+
+	AddItemsHandler struct {
+		name            string
+		addItemsCommand addItemsCommand
+	}
+
+	addItemsRequestItem struct {
+		SKU   int64  `json:"sku" validate:"nonzero"`
+		Count uint16 `json:"count" validate:"nonzero"`
+	}
+
+	addItemsRequest struct {
+		// request body
+		Items []addItemsRequestItem `json:"items" validate:"nonzero"`
+
+		// url params
+		User int64 `validate:"nonzero"`
+	}
+)
+
+func NewAddItemsHandler(command addItemsCommand, name string) *AddItemsHandler {
+	return &AddItemsHandler{
+		name:            name,
+		addItemsCommand: command,
+	}
+}
+
+func (h *AddItemsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx := r.Context()
+
+	ctx, span := otel.Tracer("cart").Start(ctx, "handler_add_cart_items")
+	defer span.End()
+
+	defer func(createdAt time.Time) {
+		prometheus.ObserveHttpRequestsDurationHistogram(createdAt, "add_cart_items")
+	}(time.Now())
+
+	prometheus.IncHttpRequestsTotalCounter("add_cart_items")
+
+	var (
+		request *addItemsRequest
+		err     error
+	)
+
+	if request, err = h.getRequestData(r); err != nil {
+		GetErrorResponse(ctx, w, h.name, err, http.StatusBadRequest)
+		return
+	}
+
+	if err = validator.Validate(request); err != nil {
+		GetErrorResponse(ctx, w, h.name, err, http.StatusBadRequest)
+		return
+	}
+
+	done := make(chan struct{})
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		defer close(done)
+		defer cancel()
+
+		select {
+		case <-ctx.Done():
+			GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done: %w", ctx.Err()), http.StatusInternalServerError)
+			return
+		default:
+			items := make([]domain.Item, len(request.Items))
+			for i, item := range request.Items {
+				items[i] = domain.Item{
+					SKU:   item.SKU,
+					Count: item.Count,
+				}
+			}
+
+			err = h.addItemsCommand.AddItems(ctx, request.User, items)
+			if err != nil {
+				var rejectedErr addbatch.RejectedError
+				if errors.As(err, &rejectedErr) {
+					h.writeRejected(ctx, w, rejectedErr)
+					return
+				}
+
+				if errors.Is(err, addbatch.ErrTooManyItems) {
+					GetErrorResponse(ctx, w, h.name, err, http.StatusBadRequest)
+					return
+				}
+
+				GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusInternalServerError)
+
+				return
+			}
+
+			GetSuccessResponse(ctx, w, h.name)
+		}
+	}()
+
+	select {
+	case <-done:
+		return
+	case <-r.Context().Done():
+		GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done while waiting for goroutine: %w", r.Context().Err()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *AddItemsHandler) writeRejected(ctx context.Context, w http.ResponseWriter, rejectedErr addbatch.RejectedError) {
+	response := AddCartItemsFailedResponse{
+		Failures: make([]AddCartItemsFailure, len(rejectedErr.Failures)),
+	}
+
+	for i, failure := range rejectedErr.Failures {
+		response.Failures[i] = AddCartItemsFailure{
+			SKU:   failure.SKU,
+			Error: failure.Err.Error(),
+		}
+	}
+
+	buf, err := json.Marshal(&response)
+	if err != nil {
+		GetErrorResponse(ctx, w, h.name, fmt.Errorf("failed to encode response %w", err), http.StatusInternalServerError)
+		return
+	}
+
+	GetErrorResponseWithBody(ctx, w, buf, h.name, http.StatusPreconditionFailed)
+}
+
+func (_ *AddItemsHandler) getRequestData(r *http.Request) (request *addItemsRequest, err error) {
+	request = &addItemsRequest{}
+	if err = json.NewDecoder(r.Body).Decode(request); err != nil {
+		return
+	}
+
+	if request.User, err = strconv.ParseInt(r.PathValue(definitions.ParamUserID), 10, 64); err != nil {
+		return
+	}
+
+	return
+}
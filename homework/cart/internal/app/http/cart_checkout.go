@@ -10,12 +10,14 @@ import (
 	"go.opentelemetry.io/otel"
 	"gopkg.in/validator.v2"
 
+	"route256/cart/internal/app/definitions"
+	"route256/cart/internal/domain"
 	"route256/cart/pkg/prometheus"
 )
 
 type (
 	cartCheckoutCommand interface {
-		CartCheckout(ctx context.Context, userID int64) (*int, error)
+		CartCheckout(ctx context.Context, userID int64, idempotencyKey string) (*domain.OrderSummary, error)
 	}
 
 	CartCheckoutHandler struct {
@@ -24,12 +26,25 @@ type (
 	}
 
 	cartCheckoutRequest struct {
-		// url params
+		// request body
 		User int64 `json:"user" validate:"nonzero"`
+
+		// headers
+		IdempotencyKey string `json:"-" validate:"max=255"`
 	}
 
 	cartCheckoutResponse struct {
-		OrderID int `json:"orderID"`
+		OrderID    int                        `json:"orderID"`
+		Status     string                     `json:"status,omitempty"`
+		Items      []cartCheckoutResponseItem `json:"items"`
+		TotalPrice int                        `json:"total_price"`
+	}
+
+	cartCheckoutResponseItem struct {
+		SKU   int64  `json:"sku"`
+		Count uint16 `json:"count"`
+		Name  string `json:"name"`
+		Price uint32 `json:"price"`
 	}
 )
 
@@ -81,17 +96,22 @@ func (h *CartCheckoutHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 			GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done: %w", ctx.Err()), http.StatusInternalServerError)
 			return
 		default:
-			orderID, err := h.cartCheckoutCommand.CartCheckout(ctx, request.User)
+			summary, err := h.cartCheckoutCommand.CartCheckout(ctx, request.User, request.IdempotencyKey)
 			if err != nil {
+				if statusCode, ok := productErrorStatusCode(err); ok {
+					GetErrorResponse(ctx, w, h.name, err, statusCode)
+					return
+				}
+
 				GetErrorResponse(ctx, w, h.name, err, http.StatusBadRequest)
+
 				return
 			}
 
-			response := cartCheckoutResponse{OrderID: *orderID}
-
-			buf, err := json.Marshal(&response)
+			buf, err := json.Marshal(newCartCheckoutResponse(summary))
 			if err != nil {
 				GetErrorResponse(ctx, w, h.name, fmt.Errorf("failed to encode response %w", err), http.StatusInternalServerError)
+				return
 			}
 
 			GetSuccessResponseWithBody(ctx, w, buf, h.name)
@@ -115,5 +135,27 @@ func (_ *CartCheckoutHandler) getRequestData(r *http.Request) (*cartCheckoutRequ
 		return nil, fmt.Errorf("failed to decode request data: %w", err)
 	}
 
+	request.IdempotencyKey = r.Header.Get(definitions.HeaderIdempotencyKey)
+
 	return request, nil
 }
+
+func newCartCheckoutResponse(summary *domain.OrderSummary) *cartCheckoutResponse {
+	response := &cartCheckoutResponse{
+		OrderID:    summary.OrderID,
+		Status:     summary.Status,
+		Items:      make([]cartCheckoutResponseItem, len(summary.Items)),
+		TotalPrice: summary.TotalPrice,
+	}
+
+	for i, item := range summary.Items {
+		response.Items[i] = cartCheckoutResponseItem{
+			SKU:   item.SKU,
+			Count: item.Count,
+			Name:  item.Name,
+			Price: item.Price,
+		}
+	}
+
+	return response
+}
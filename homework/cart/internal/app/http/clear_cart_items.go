@@ -16,7 +16,7 @@ import (
 
 type (
 	clearCartItemsCommand interface {
-		DeleteItemsByUserID(ctx context.Context, userID int64)
+		DeleteItemsByUserID(ctx context.Context, userID int64) error
 	}
 
 	ClearCartItemsHandler struct {
@@ -77,11 +77,16 @@ func (h *ClearCartItemsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 			GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done: %w", ctx.Err()), http.StatusInternalServerError)
 			return
 		default:
-			h.clearCartItemsCommand.DeleteItemsByUserID(
+			err = h.clearCartItemsCommand.DeleteItemsByUserID(
 				ctx,
 				request.User,
 			)
 
+			if err != nil {
+				GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusInternalServerError)
+				return
+			}
+
 			GetNoContentResponse(ctx, w, h.name)
 		}
 	}()
@@ -16,7 +16,7 @@ import (
 
 type (
 	deleteItemCommand interface {
-		DeleteItem(ctx context.Context, userID, skuID int64)
+		DeleteItem(ctx context.Context, userID, skuID int64) error
 	}
 
 	DeleteItemHandler struct {
@@ -79,12 +79,17 @@ func (h *DeleteItemHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done: %w", ctx.Err()), http.StatusInternalServerError)
 			return
 		default:
-			h.deleteItemCommand.DeleteItem(
+			err = h.deleteItemCommand.DeleteItem(
 				ctx,
 				request.User,
 				request.SKU,
 			)
 
+			if err != nil {
+				GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusInternalServerError)
+				return
+			}
+
 			GetNoContentResponse(ctx, w, h.name)
 		}
 	}()
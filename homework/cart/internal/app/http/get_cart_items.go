@@ -14,7 +14,6 @@ import (
 
 	"route256/cart/internal/app/definitions"
 	"route256/cart/internal/domain"
-	"route256/cart/internal/repository/memorycartrepo"
 	"route256/cart/pkg/prometheus"
 )
 
@@ -89,11 +88,16 @@ func (h *GetCartItemsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		default:
 			cartItems, err := h.getCartItemsCommand.GetItemsByUserID(ctx, request.User)
 			if err != nil {
-				if errors.Is(err, memorycartrepo.CartItemsNotFoundError{}) {
+				if errors.Is(err, domain.CartItemsNotFoundError{}) {
 					GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusNotFound)
 					return
 				}
 
+				if statusCode, ok := productErrorStatusCode(err); ok {
+					GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), statusCode)
+					return
+				}
+
 				GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusInternalServerError)
 
 				return
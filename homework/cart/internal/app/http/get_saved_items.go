@@ -0,0 +1,130 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"gopkg.in/validator.v2"
+
+	"route256/cart/internal/app/definitions"
+	"route256/cart/internal/domain"
+	"route256/cart/pkg/prometheus"
+)
+
+// GetSavedItemsResponse has no total price, as saved items are not going to be bought with the cart.
+type GetSavedItemsResponse struct {
+	Items []domain.ListItem `json:"items"`
+}
+
+type (
+	getSavedItemsCommand interface {
+		GetItemsByUserID(ctx context.Context, userID int64) ([]domain.ListItem, error)
+	}
+
+	GetSavedItemsHandler struct {
+		name                 string
+		getSavedItemsCommand getSavedItemsCommand
+	}
+
+	getSavedItemsRequest struct {
+		// url params
+		User int64 `validate:"nonzero"`
+	}
+)
+
+func NewGetSavedItemsHandler(command getSavedItemsCommand, name string) *GetSavedItemsHandler {
+	return &GetSavedItemsHandler{
+		name:                 name,
+		getSavedItemsCommand: command,
+	}
+}
+
+func (h *GetSavedItemsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx := r.Context()
+
+	ctx, span := otel.Tracer("cart").Start(ctx, "handler_get_saved_items")
+	defer span.End()
+
+	defer func(createdAt time.Time) {
+		prometheus.ObserveHttpRequestsDurationHistogram(createdAt, "get_saved_items")
+	}(time.Now())
+
+	prometheus.IncHttpRequestsTotalCounter("get_saved_items")
+
+	var (
+		request *getSavedItemsRequest
+		err     error
+	)
+
+	if request, err = h.getRequestData(r); err != nil {
+		GetErrorResponse(ctx, w, h.name, err, http.StatusBadRequest)
+		return
+	}
+
+	if err = validator.Validate(request); err != nil {
+		GetErrorResponse(ctx, w, h.name, err, http.StatusBadRequest)
+		return
+	}
+
+	done := make(chan struct{})
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		defer close(done)
+		defer cancel()
+
+		select {
+		case <-ctx.Done():
+			GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done: %w", ctx.Err()), http.StatusInternalServerError)
+			return
+		default:
+			savedItems, err := h.getSavedItemsCommand.GetItemsByUserID(ctx, request.User)
+			if err != nil {
+				if errors.Is(err, domain.CartItemsNotFoundError{}) {
+					GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusNotFound)
+					return
+				}
+
+				GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusInternalServerError)
+
+				return
+			}
+
+			response := GetSavedItemsResponse{}
+			response.Items = savedItems
+
+			buf, err := json.Marshal(&response)
+			if err != nil {
+				GetErrorResponse(ctx, w, h.name, fmt.Errorf("failed to encode response %w", err), http.StatusInternalServerError)
+			}
+
+			GetSuccessResponseWithBody(ctx, w, buf, h.name)
+		}
+	}()
+
+	select {
+	case <-done:
+		return
+	case <-r.Context().Done():
+		GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done while waiting for goroutine: %w", r.Context().Err()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (_ *GetSavedItemsHandler) getRequestData(r *http.Request) (request *getSavedItemsRequest, err error) {
+	request = &getSavedItemsRequest{}
+
+	if request.User, err = strconv.ParseInt(r.PathValue(definitions.ParamUserID), 10, 64); err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,150 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"gopkg.in/validator.v2"
+
+	"route256/cart/internal/app/definitions"
+	"route256/cart/internal/domain"
+	"route256/cart/internal/service/cart/merge"
+	"route256/cart/pkg/prometheus"
+)
+
+type (
+	mergeCartsCommand interface {
+		MergeCarts(ctx context.Context, fromUserID, toUserID int64) ([]domain.ListItem, error)
+	}
+
+	MergeCartsHandler struct {
+		name              string
+		mergeCartsCommand mergeCartsCommand
+	}
+
+	mergeCartsRequest struct {
+		// url params
+		FromUser int64 `validate:"nonzero"`
+		ToUser   int64 `validate:"nonzero"`
+	}
+)
+
+var errMergeSameCart = errors.New("from_user and to_user must differ")
+
+func NewMergeCartsHandler(command mergeCartsCommand, name string) *MergeCartsHandler {
+	return &MergeCartsHandler{
+		name:              name,
+		mergeCartsCommand: command,
+	}
+}
+
+func (h *MergeCartsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx := r.Context()
+
+	ctx, span := otel.Tracer("cart").Start(ctx, "handler_merge_carts")
+	defer span.End()
+
+	defer func(createdAt time.Time) {
+		prometheus.ObserveHttpRequestsDurationHistogram(createdAt, "merge_carts")
+	}(time.Now())
+
+	prometheus.IncHttpRequestsTotalCounter("merge_carts")
+
+	var (
+		request *mergeCartsRequest
+		err     error
+	)
+
+	if request, err = h.getRequestData(r); err != nil {
+		GetErrorResponse(ctx, w, h.name, err, http.StatusBadRequest)
+		return
+	}
+
+	if err = validator.Validate(request); err != nil {
+		GetErrorResponse(ctx, w, h.name, err, http.StatusBadRequest)
+		return
+	}
+
+	if request.FromUser == request.ToUser {
+		GetErrorResponse(ctx, w, h.name, errMergeSameCart, http.StatusBadRequest)
+		return
+	}
+
+	done := make(chan struct{})
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		defer close(done)
+		defer cancel()
+
+		select {
+		case <-ctx.Done():
+			GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done: %w", ctx.Err()), http.StatusInternalServerError)
+			return
+		default:
+			cartItems, err := h.mergeCartsCommand.MergeCarts(ctx, request.FromUser, request.ToUser)
+			if err != nil {
+				if errors.Is(err, domain.CartItemsNotFoundError{}) {
+					GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusNotFound)
+					return
+				}
+
+				if errors.Is(err, merge.ErrInsufficientStocks) || errors.Is(err, merge.ErrCountOverflow) {
+					GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusPreconditionFailed)
+					return
+				}
+
+				if statusCode, ok := productErrorStatusCode(err); ok {
+					GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), statusCode)
+					return
+				}
+
+				GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusInternalServerError)
+
+				return
+			}
+
+			response := GetCartItemsResponse{}
+			response.Items = cartItems
+			response.TotalPrice = getTotalPrice(cartItems)
+
+			buf, err := json.Marshal(&response)
+			if err != nil {
+				GetErrorResponse(ctx, w, h.name, fmt.Errorf("failed to encode response %w", err), http.StatusInternalServerError)
+				return
+			}
+
+			GetSuccessResponseWithBody(ctx, w, buf, h.name)
+		}
+	}()
+
+	select {
+	case <-done:
+		return
+	case <-r.Context().Done():
+		GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done while waiting for goroutine: %w", r.Context().Err()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (_ *MergeCartsHandler) getRequestData(r *http.Request) (request *mergeCartsRequest, err error) {
+	request = &mergeCartsRequest{}
+
+	if request.FromUser, err = strconv.ParseInt(r.PathValue(definitions.ParamFromUserID), 10, 64); err != nil {
+		return
+	}
+
+	if request.ToUser, err = strconv.ParseInt(r.PathValue(definitions.ParamToUserID), 10, 64); err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,171 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"gopkg.in/validator.v2"
+
+	"route256/cart/internal/app/definitions"
+	"route256/cart/internal/domain"
+	"route256/cart/pkg/prometheus"
+)
+
+type (
+	previewCheckoutCommand interface {
+		PreviewCheckout(ctx context.Context, userID int64) (*domain.CheckoutPreview, error)
+	}
+
+	PreviewCheckoutHandler struct {
+		name                   string
+		previewCheckoutCommand previewCheckoutCommand
+	}
+
+	previewCheckoutRequest struct {
+		// url params
+		User int64 `validate:"nonzero"`
+	}
+
+	previewCheckoutResponse struct {
+		CanCheckout bool                             `json:"can_checkout"`
+		Items       []cartCheckoutResponseItem       `json:"items"`
+		TotalPrice  int                              `json:"total_price"`
+		Failures    []previewCheckoutResponseFailure `json:"failures"`
+	}
+
+	previewCheckoutResponseFailure struct {
+		SKU            int64  `json:"sku"`
+		Count          uint16 `json:"count"`
+		AvailableCount int    `json:"available_count"`
+		Reason         string `json:"reason"`
+	}
+)
+
+func NewPreviewCheckoutHandler(command previewCheckoutCommand, name string) *PreviewCheckoutHandler {
+	return &PreviewCheckoutHandler{
+		name:                   name,
+		previewCheckoutCommand: command,
+	}
+}
+
+func (h *PreviewCheckoutHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx := r.Context()
+
+	ctx, span := otel.Tracer("cart").Start(ctx, "handler_preview_cart_checkout")
+	defer span.End()
+
+	defer func(createdAt time.Time) {
+		prometheus.ObserveHttpRequestsDurationHistogram(createdAt, "preview_cart_checkout")
+	}(time.Now())
+
+	prometheus.IncHttpRequestsTotalCounter("preview_cart_checkout")
+
+	var (
+		request *previewCheckoutRequest
+		err     error
+	)
+
+	if request, err = h.getRequestData(r); err != nil {
+		GetErrorResponse(ctx, w, h.name, err, http.StatusBadRequest)
+		return
+	}
+
+	if err = validator.Validate(request); err != nil {
+		GetErrorResponse(ctx, w, h.name, err, http.StatusBadRequest)
+		return
+	}
+
+	done := make(chan struct{})
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		defer close(done)
+		defer cancel()
+
+		select {
+		case <-ctx.Done():
+			GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done: %w", ctx.Err()), http.StatusInternalServerError)
+			return
+		default:
+			preview, err := h.previewCheckoutCommand.PreviewCheckout(ctx, request.User)
+			if err != nil {
+				if errors.Is(err, domain.CartItemsNotFoundError{}) {
+					GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusNotFound)
+					return
+				}
+
+				if statusCode, ok := productErrorStatusCode(err); ok {
+					GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), statusCode)
+					return
+				}
+
+				GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusInternalServerError)
+
+				return
+			}
+
+			buf, err := json.Marshal(newPreviewCheckoutResponse(preview))
+			if err != nil {
+				GetErrorResponse(ctx, w, h.name, fmt.Errorf("failed to encode response %w", err), http.StatusInternalServerError)
+				return
+			}
+
+			GetSuccessResponseWithBody(ctx, w, buf, h.name)
+		}
+	}()
+
+	select {
+	case <-done:
+		return
+	case <-r.Context().Done():
+		GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done while waiting for goroutine: %w", r.Context().Err()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (_ *PreviewCheckoutHandler) getRequestData(r *http.Request) (request *previewCheckoutRequest, err error) {
+	request = &previewCheckoutRequest{}
+
+	if request.User, err = strconv.ParseInt(r.PathValue(definitions.ParamUserID), 10, 64); err != nil {
+		return
+	}
+
+	return
+}
+
+func newPreviewCheckoutResponse(preview *domain.CheckoutPreview) *previewCheckoutResponse {
+	response := &previewCheckoutResponse{
+		CanCheckout: len(preview.Failures) == 0,
+		Items:       make([]cartCheckoutResponseItem, len(preview.Items)),
+		TotalPrice:  preview.TotalPrice,
+		Failures:    make([]previewCheckoutResponseFailure, len(preview.Failures)),
+	}
+
+	for i, item := range preview.Items {
+		response.Items[i] = cartCheckoutResponseItem{
+			SKU:   item.SKU,
+			Count: item.Count,
+			Name:  item.Name,
+			Price: item.Price,
+		}
+	}
+
+	for i, failure := range preview.Failures {
+		response.Failures[i] = previewCheckoutResponseFailure{
+			SKU:            failure.SKU,
+			Count:          failure.Count,
+			AvailableCount: failure.AvailableCount,
+			Reason:         failure.Reason,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,27 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"route256/cart/internal/clients/product"
+)
+
+// productErrorStatusCode maps a product service failure to the response status,
+// false means the error did not come from the product service.
+func productErrorStatusCode(err error) (int, bool) {
+	switch {
+	case errors.Is(err, product.ErrProductNotFound):
+		return http.StatusPreconditionFailed, true
+	case errors.Is(err, product.ErrRateLimited):
+		return http.StatusTooManyRequests, true
+	case errors.Is(err, product.ErrUpstreamUnavailable):
+		return http.StatusServiceUnavailable, true
+	case errors.Is(err, product.ErrBadRequest):
+		return http.StatusBadRequest, true
+	case errors.Is(err, product.ErrUnauthorized), errors.Is(err, product.ErrMalformedResponse):
+		return http.StatusBadGateway, true
+	default:
+		return 0, false
+	}
+}
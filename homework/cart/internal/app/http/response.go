@@ -36,3 +36,10 @@ func GetSuccessResponseWithBody(ctx context.Context, w http.ResponseWriter, body
 
 	_, _ = w.Write(body)
 }
+
+func GetErrorResponseWithBody(ctx context.Context, w http.ResponseWriter, body []byte, handlerName string, statusCode int) {
+	w.WriteHeader(statusCode)
+	prometheus.IncHttpResponseStatusTotalCounter(handlerName, strconv.Itoa(statusCode))
+
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,126 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"gopkg.in/validator.v2"
+
+	"route256/cart/internal/app/definitions"
+	"route256/cart/internal/service/cart/saved/restore"
+	"route256/cart/pkg/prometheus"
+)
+
+type (
+	restoreItemCommand interface {
+		RestoreItem(ctx context.Context, userID, skuID int64) error
+	}
+
+	RestoreItemHandler struct {
+		name               string
+		restoreItemCommand restoreItemCommand
+	}
+	restoreItemRequest struct {
+		// url params
+		SKU  int64 `validate:"nonzero"`
+		User int64 `validate:"nonzero"`
+	}
+)
+
+func NewRestoreItemHandler(command restoreItemCommand, name string) *RestoreItemHandler {
+	return &RestoreItemHandler{
+		name:               name,
+		restoreItemCommand: command,
+	}
+}
+
+func (h *RestoreItemHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx := r.Context()
+
+	ctx, span := otel.Tracer("cart").Start(ctx, "handler_restore_saved_item")
+	defer span.End()
+
+	defer func(createdAt time.Time) {
+		prometheus.ObserveHttpRequestsDurationHistogram(createdAt, "restore_saved_item")
+	}(time.Now())
+
+	prometheus.IncHttpRequestsTotalCounter("restore_saved_item")
+
+	var (
+		request *restoreItemRequest
+		err     error
+	)
+
+	if request, err = h.getRequestData(r); err != nil {
+		GetErrorResponse(ctx, w, h.name, err, http.StatusBadRequest)
+		return
+	}
+
+	if err = validator.Validate(request); err != nil {
+		GetErrorResponse(ctx, w, h.name, err, http.StatusBadRequest)
+		return
+	}
+
+	done := make(chan struct{})
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		defer close(done)
+		defer cancel()
+
+		select {
+		case <-ctx.Done():
+			GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done: %w", ctx.Err()), http.StatusInternalServerError)
+			return
+		default:
+			err = h.restoreItemCommand.RestoreItem(ctx, request.User, request.SKU)
+			if err != nil {
+				if errors.Is(err, restore.ErrItemNotSaved) {
+					GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusNotFound)
+					return
+				}
+
+				if errors.Is(err, restore.ErrInsufficientStocks) {
+					GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusPreconditionFailed)
+					return
+				}
+
+				GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusInternalServerError)
+
+				return
+			}
+
+			GetSuccessResponse(ctx, w, h.name)
+		}
+	}()
+
+	select {
+	case <-done:
+		return
+	case <-r.Context().Done():
+		GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done while waiting for goroutine: %w", r.Context().Err()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (_ *RestoreItemHandler) getRequestData(r *http.Request) (request *restoreItemRequest, err error) {
+	request = &restoreItemRequest{}
+
+	if request.User, err = strconv.ParseInt(r.PathValue(definitions.ParamUserID), 10, 64); err != nil {
+		return
+	}
+
+	if request.SKU, err = strconv.ParseInt(r.PathValue(definitions.ParamSkuID), 10, 64); err != nil {
+		return
+	}
+
+	return
+}
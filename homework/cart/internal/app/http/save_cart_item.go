@@ -0,0 +1,121 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"gopkg.in/validator.v2"
+
+	"route256/cart/internal/app/definitions"
+	"route256/cart/internal/service/cart/saved/save"
+	"route256/cart/pkg/prometheus"
+)
+
+type (
+	saveItemCommand interface {
+		SaveItem(ctx context.Context, userID, skuID int64) error
+	}
+
+	SaveItemHandler struct {
+		name            string
+		saveItemCommand saveItemCommand
+	}
+	saveItemRequest struct {
+		// url params
+		SKU  int64 `validate:"nonzero"`
+		User int64 `validate:"nonzero"`
+	}
+)
+
+func NewSaveItemHandler(command saveItemCommand, name string) *SaveItemHandler {
+	return &SaveItemHandler{
+		name:            name,
+		saveItemCommand: command,
+	}
+}
+
+func (h *SaveItemHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	ctx := r.Context()
+
+	ctx, span := otel.Tracer("cart").Start(ctx, "handler_save_cart_item")
+	defer span.End()
+
+	defer func(createdAt time.Time) {
+		prometheus.ObserveHttpRequestsDurationHistogram(createdAt, "save_cart_item")
+	}(time.Now())
+
+	prometheus.IncHttpRequestsTotalCounter("save_cart_item")
+
+	var (
+		request *saveItemRequest
+		err     error
+	)
+
+	if request, err = h.getRequestData(r); err != nil {
+		GetErrorResponse(ctx, w, h.name, err, http.StatusBadRequest)
+		return
+	}
+
+	if err = validator.Validate(request); err != nil {
+		GetErrorResponse(ctx, w, h.name, err, http.StatusBadRequest)
+		return
+	}
+
+	done := make(chan struct{})
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		defer close(done)
+		defer cancel()
+
+		select {
+		case <-ctx.Done():
+			GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done: %w", ctx.Err()), http.StatusInternalServerError)
+			return
+		default:
+			err = h.saveItemCommand.SaveItem(ctx, request.User, request.SKU)
+			if err != nil {
+				if errors.Is(err, save.ErrItemNotInCart) {
+					GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusNotFound)
+					return
+				}
+
+				GetErrorResponse(ctx, w, h.name, fmt.Errorf("command handler failed: %w", err), http.StatusInternalServerError)
+
+				return
+			}
+
+			GetSuccessResponse(ctx, w, h.name)
+		}
+	}()
+
+	select {
+	case <-done:
+		return
+	case <-r.Context().Done():
+		GetErrorResponse(ctx, w, h.name, fmt.Errorf("request context done while waiting for goroutine: %w", r.Context().Err()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (_ *SaveItemHandler) getRequestData(r *http.Request) (request *saveItemRequest, err error) {
+	request = &saveItemRequest{}
+
+	if request.User, err = strconv.ParseInt(r.PathValue(definitions.ParamUserID), 10, 64); err != nil {
+		return
+	}
+
+	if request.SKU, err = strconv.ParseInt(r.PathValue(definitions.ParamSkuID), 10, 64); err != nil {
+		return
+	}
+
+	return
+}
@@ -2,18 +2,50 @@ package loms
 
 import (
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+
+	"route256/cart/internal/clients/loms/middleware"
 )
 
-type Client struct {
-	header string
-	conn   *grpc.ClientConn
-}
+const (
+	infoStocksMethod = "/route256.loms.pkg.loms.pkg.loms.v1.LOMS/InfoStocks"
+	infoOrderMethod  = "/route256.loms.pkg.loms.pkg.loms.v1.LOMS/InfoOrder"
+)
+
+type (
+	Client struct {
+		header string
+		conn   *grpc.ClientConn
+	}
+
+	// Options configures resilience of the calls, zero BreakerFailures disables the circuit breaker.
+	Options struct {
+		Timeout         time.Duration
+		MaxRetries      int
+		BreakerFailures uint32
+		BreakerTimeout  time.Duration
+	}
+)
+
+func NewClient(header, addr string, opts Options) (*Client, error) {
+	// Дедлайн покрывает все ретраи вызова, breaker видит результат вызова уже после ретраев
+	interceptors := []grpc.UnaryClientInterceptor{middleware.Deadline(opts.Timeout)}
+	if opts.BreakerFailures > 0 {
+		interceptors = append(interceptors, middleware.CircuitBreaker("loms", opts.BreakerFailures, opts.BreakerTimeout))
+	}
+
+	interceptors = append(interceptors,
+		middleware.Retry(opts.MaxRetries, 0, 0, infoStocksMethod, infoOrderMethod),
+		middleware.Metrics,
+	)
 
-func NewClient(header, addr string) (*Client, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(interceptors...),
+	)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create new gRPC loms client: %w", err)
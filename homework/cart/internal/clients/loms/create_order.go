@@ -17,9 +17,13 @@ import (
 	"route256/cart/pkg/prometheus"
 )
 
+const idempotencyKeyHeader = "idempotency-key"
+
 var ErrCreateOrder = errors.New("LOMSService.CreateOrder failed: ")
 
-func (c *Client) CreateOrder(ctx context.Context, userID int64, items []domain.Item) (int, error) {
+// CreateOrder creates an order in loms, non-empty idempotencyKey is sent in the idempotency-key metadata
+// so that loms can deduplicate repeated requests.
+func (c *Client) CreateOrder(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) (int, error) {
 	ctx, span := otel.Tracer("cart").Start(ctx, "loms_client_create_order")
 	defer span.End()
 
@@ -28,11 +32,11 @@ func (c *Client) CreateOrder(ctx context.Context, userID int64, items []domain.I
 	}(time.Now())
 
 	client := desc.NewLOMSClient(c.conn)
-	ctx, cancel := context.WithTimeout(ctx, time.Second)
-
-	defer cancel()
 
 	ctx = metadata.AppendToOutgoingContext(ctx, "x-auth", c.header)
+	if idempotencyKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, idempotencyKeyHeader, idempotencyKey)
+	}
 
 	responseItems := repackItems(items)
 
@@ -0,0 +1,62 @@
+package loms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"route256/cart/internal/domain"
+	desc "route256/cart/pkg/api/loms/v1"
+	"route256/cart/pkg/prometheus"
+)
+
+var ErrInfoOrder = errors.New("LOMSService.InfoOrder failed: ")
+
+func (c *Client) InfoOrder(ctx context.Context, orderID int) (*domain.Order, error) {
+	ctx, span := otel.Tracer("cart").Start(ctx, "loms_client_info_order")
+	defer span.End()
+
+	defer func(createdAt time.Time) {
+		prometheus.ObserveExternalRequestsDurationHistogram(createdAt, "loms", "info_order")
+	}(time.Now())
+
+	client := desc.NewLOMSClient(c.conn)
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-auth", c.header)
+
+	prometheus.IncExternalRequestsTotalCounter("loms", "info_order")
+
+	traceId := span.SpanContext().TraceID().String()
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceId)
+
+	response, err := client.InfoOrder(ctx, &desc.InfoOrderRequest{OrderId: int64(orderID)})
+
+	if err != nil {
+		prometheus.IncExternalResponseStatusTotalCounter("GET /v1/order/{order_id}/info", strconv.FormatUint(uint64(status.Code(err)), 10))
+
+		return nil, fmt.Errorf("error when calling InfoOrder: %w", err)
+	}
+
+	prometheus.IncExternalResponseStatusTotalCounter("GET /v1/order/{order_id}/info", strconv.FormatUint(uint64(codes.OK), 10))
+
+	items := make([]domain.Item, len(response.Items))
+	for i, item := range response.Items {
+		items[i] = domain.Item{
+			SKU:   int64(item.GetSku()),
+			Count: uint16(item.GetCount()),
+		}
+	}
+
+	return &domain.Order{
+		Status: response.Status,
+		UserID: response.User,
+		Items:  items,
+	}, nil
+}
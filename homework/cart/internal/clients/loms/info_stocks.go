@@ -16,7 +16,11 @@ import (
 	"route256/cart/pkg/prometheus"
 )
 
-var ErrGetStockInfo = errors.New("LOMSService.InfoStocks failed: ")
+var (
+	ErrGetStockInfo = errors.New("LOMSService.InfoStocks failed: ")
+	// ErrStockNotFound is returned when loms has no stock row for the sku
+	ErrStockNotFound = errors.New("stock not found")
+)
 
 func (c *Client) InfoStocks(ctx context.Context, sku int64) (int, error) {
 	ctx, span := otel.Tracer("cart").Start(ctx, "loms_client_info_stocks")
@@ -27,9 +31,6 @@ func (c *Client) InfoStocks(ctx context.Context, sku int64) (int, error) {
 	}(time.Now())
 
 	client := desc.NewLOMSClient(c.conn)
-	ctx, cancel := context.WithTimeout(ctx, time.Second)
-
-	defer cancel()
 
 	ctx = metadata.AppendToOutgoingContext(ctx, "x-auth", c.header)
 
@@ -43,6 +44,10 @@ func (c *Client) InfoStocks(ctx context.Context, sku int64) (int, error) {
 	if err != nil {
 		prometheus.IncExternalResponseStatusTotalCounter("GET /v1/stock/{sku}/info", strconv.FormatUint(uint64(status.Code(err)), 10))
 
+		if status.Code(err) == codes.NotFound {
+			return 0, fmt.Errorf("error when calling InfoStocks: %w %w", ErrStockNotFound, err)
+		}
+
 		return 0, fmt.Errorf("error when calling InfoStocks: %w", err)
 	}
 
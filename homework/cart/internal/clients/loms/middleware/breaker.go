@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"route256/cart/pkg/prometheus"
+)
+
+// CircuitBreaker fails calls fast with codes.Unavailable after maxFailures consecutive server failures.
+// After openTimeout a single trial call is let through: its success closes the breaker, its failure opens it again.
+func CircuitBreaker(name string, maxFailures uint32, openTimeout time.Duration) grpc.UnaryClientInterceptor {
+	prometheus.SetCircuitBreakerState(int(gobreaker.StateClosed), name)
+
+	breaker := gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:        name,
+		MaxRequests: 1,
+		Timeout:     openTimeout,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= maxFailures
+		},
+		OnStateChange: func(name string, _ gobreaker.State, to gobreaker.State) {
+			prometheus.SetCircuitBreakerState(int(to), name)
+		},
+		IsSuccessful: isSuccessful,
+	})
+
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		_, err := breaker.Execute(func() (interface{}, error) {
+			return nil, invoker(ctx, method, req, reply, cc, opts...)
+		})
+
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			return status.Errorf(codes.Unavailable, "%s circuit breaker: %v", name, err)
+		}
+
+		return err
+	}
+}
+
+// isSuccessful counts only statuses of an unhealthy server as failures,
+// business errors such as NotFound or FailedPrecondition are valid answers.
+func isSuccessful(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown, codes.ResourceExhausted:
+		return false
+	default:
+		return true
+	}
+}
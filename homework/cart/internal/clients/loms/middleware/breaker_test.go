@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCircuitBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	interceptor := CircuitBreaker("test_open", 2, time.Minute)
+	invoker := invokerReturning(&calls,
+		status.Error(codes.Unavailable, "unavailable"),
+		status.Error(codes.DeadlineExceeded, "deadline"),
+	)
+
+	for i := 0; i < 2; i++ {
+		err := interceptor(context.Background(), testMethod, nil, nil, nil, invoker)
+		require.Error(t, err)
+	}
+
+	// открытый breaker не обращается к сервису
+	err := interceptor(context.Background(), testMethod, nil, nil, nil, invoker)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Contains(t, err.Error(), "circuit breaker")
+	require.Equal(t, 2, calls)
+}
+
+func TestCircuitBreakerIgnoresBusinessErrors(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	interceptor := CircuitBreaker("test_business", 1, time.Minute)
+	invoker := invokerReturning(&calls,
+		status.Error(codes.NotFound, "not found"),
+		status.Error(codes.FailedPrecondition, "insufficient stocks"),
+		nil,
+	)
+
+	for i := 0; i < 3; i++ {
+		_ = interceptor(context.Background(), testMethod, nil, nil, nil, invoker)
+	}
+
+	require.Equal(t, 3, calls)
+}
+
+func TestCircuitBreakerClosesAfterSuccessfulTrial(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	interceptor := CircuitBreaker("test_half_open", 1, 10*time.Millisecond)
+	invoker := invokerReturning(&calls,
+		status.Error(codes.Unavailable, "unavailable"),
+		nil,
+		nil,
+	)
+
+	err := interceptor(context.Background(), testMethod, nil, nil, nil, invoker)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	time.Sleep(20 * time.Millisecond)
+
+	for i := 0; i < 2; i++ {
+		err = interceptor(context.Background(), testMethod, nil, nil, nil, invoker)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 3, calls)
+}
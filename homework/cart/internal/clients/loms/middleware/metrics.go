@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"route256/cart/pkg/prometheus"
+)
+
+// Metrics counts every attempt sent to the server, the client side pair of the server grpc_requests_total_counter.
+func Metrics(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	handler := path.Base(method)
+
+	defer func(createdAt time.Time) {
+		prometheus.ObserveGRPCClientRequestsDurationHistogram(createdAt, handler)
+	}(time.Now())
+
+	prometheus.IncGRPCClientRequestsTotalCounter(handler)
+
+	err := invoker(ctx, method, req, reply, cc, opts...)
+
+	prometheus.IncGRPCClientResponseStatusTotalCounter(handler, strconv.FormatUint(uint64(status.Code(err)), 10))
+
+	return err
+}
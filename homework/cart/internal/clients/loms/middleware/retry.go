@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"context"
+	"math/rand/v2"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"route256/cart/pkg/prometheus"
+)
+
+const (
+	defaultBaseDelay = 50 * time.Millisecond
+	defaultMaxDelay  = time.Second
+)
+
+// Retry repeats calls of the given idempotent methods failed with a transient status.
+// maxRetries is the number of attempts after the first one, delays grow exponentially with jitter.
+func Retry(maxRetries int, baseDelay, maxDelay time.Duration, methods ...string) grpc.UnaryClientInterceptor {
+	if baseDelay <= 0 {
+		baseDelay = defaultBaseDelay
+	}
+
+	if maxDelay <= 0 {
+		maxDelay = defaultMaxDelay
+	}
+
+	idempotent := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		idempotent[method] = struct{}{}
+	}
+
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := idempotent[method]; !ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		for attempt := 0; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt >= maxRetries || !isRetryable(ctx, err) {
+				return err
+			}
+
+			delay := backoff(attempt, baseDelay, maxDelay)
+
+			// Не ждём ретрая, который всё равно не успеет до дедлайна вызова
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+				return err
+			}
+
+			prometheus.IncGRPCClientRetriesTotalCounter(path.Base(method), status.Code(err).String())
+
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+
+				return err
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff returns exponential delay with equal jitter: half of it is fixed and the other half is random.
+func backoff(attempt int, baseDelay, maxDelay time.Duration) time.Duration {
+	delay := min(baseDelay<<attempt, maxDelay)
+	if delay <= 0 {
+		delay = maxDelay
+	}
+
+	half := delay / 2
+
+	return half + rand.N(half+1)
+}
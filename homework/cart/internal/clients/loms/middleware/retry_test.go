@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testMethod       = "/loms.LOMS/InfoStocks"
+	testOtherMethod  = "/loms.LOMS/CreateOrder"
+	testRetryBase    = time.Millisecond
+	testRetryMaxWait = 5 * time.Millisecond
+)
+
+// invokerReturning returns invoker answering with the given errors in turn and counting its calls.
+func invokerReturning(calls *int, errs ...error) grpc.UnaryInvoker {
+	return func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		err := errs[*calls]
+		*calls++
+
+		return err
+	}
+}
+
+func TestRetryTable(t *testing.T) {
+	t.Parallel()
+
+	type data struct {
+		name      string
+		method    string
+		errs      []error
+		wantCalls int
+		wantCode  codes.Code
+	}
+
+	testData := []data{
+		{
+			name:      "success without retries",
+			method:    testMethod,
+			errs:      []error{nil},
+			wantCalls: 1,
+			wantCode:  codes.OK,
+		},
+		{
+			name:   "transient errors are retried",
+			method: testMethod,
+			errs: []error{
+				status.Error(codes.Unavailable, "unavailable"),
+				status.Error(codes.ResourceExhausted, "exhausted"),
+				nil,
+			},
+			wantCalls: 3,
+			wantCode:  codes.OK,
+		},
+		{
+			name:   "last error is returned when retries are exhausted",
+			method: testMethod,
+			errs: []error{
+				status.Error(codes.Unavailable, "unavailable"),
+				status.Error(codes.Unavailable, "unavailable"),
+				status.Error(codes.Unavailable, "unavailable"),
+			},
+			wantCalls: 3,
+			wantCode:  codes.Unavailable,
+		},
+		{
+			name:      "business errors are not retried",
+			method:    testMethod,
+			errs:      []error{status.Error(codes.NotFound, "not found")},
+			wantCalls: 1,
+			wantCode:  codes.NotFound,
+		},
+		{
+			name:      "not idempotent methods are not retried",
+			method:    testOtherMethod,
+			errs:      []error{status.Error(codes.Unavailable, "unavailable")},
+			wantCalls: 1,
+			wantCode:  codes.Unavailable,
+		},
+	}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			interceptor := Retry(2, testRetryBase, testRetryMaxWait, testMethod)
+
+			err := interceptor(context.Background(), tt.method, nil, nil, nil, invokerReturning(&calls, tt.errs...))
+			require.Equal(t, tt.wantCode, status.Code(err))
+			require.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
+func TestRetryRespectsDeadline(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	interceptor := Retry(3, time.Second, time.Second, testMethod)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err := interceptor(ctx, testMethod, nil, nil, nil, invokerReturning(&calls,
+		status.Error(codes.Unavailable, "unavailable"),
+		nil,
+	))
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Equal(t, 1, calls)
+}
+
+func TestDeadline(t *testing.T) {
+	t.Parallel()
+
+	interceptor := Deadline(time.Second)
+
+	err := interceptor(context.Background(), testMethod, nil, nil, nil, func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		require.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,101 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"go.opentelemetry.io/otel"
+
+	"route256/cart/internal/clients/product"
+	"route256/cart/internal/domain"
+	"route256/cart/pkg/prometheus"
+)
+
+const breakerName = "product"
+
+var ErrCircuitOpen = fmt.Errorf("%w: circuit breaker is open", product.ErrUpstreamUnavailable)
+
+type (
+	productClient interface {
+		GetProductInfo(ctx context.Context, sku uint32) (*domain.Product, error)
+		GetProductsInfo(ctx context.Context, skus []uint32) (map[uint32]*domain.Product, error)
+	}
+
+	// Client fails fast without calling the wrapped client after maxFailures consecutive failures.
+	// After openTimeout a single trial request is let through: its success closes the breaker, its failure opens it again.
+	Client struct {
+		client  productClient
+		breaker *gobreaker.CircuitBreaker
+	}
+)
+
+func New(client productClient, maxFailures uint32, openTimeout time.Duration) *Client {
+	prometheus.SetCircuitBreakerState(int(gobreaker.StateClosed), breakerName)
+
+	return &Client{
+		client: client,
+		breaker: gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:        breakerName,
+			MaxRequests: 1,
+			Timeout:     openTimeout,
+			ReadyToTrip: func(counts gobreaker.Counts) bool {
+				return counts.ConsecutiveFailures >= maxFailures
+			},
+			OnStateChange: func(name string, _ gobreaker.State, to gobreaker.State) {
+				prometheus.SetCircuitBreakerState(int(to), name)
+			},
+			IsSuccessful: isSuccessful,
+		}),
+	}
+}
+
+func (c *Client) GetProductInfo(ctx context.Context, sku uint32) (*domain.Product, error) {
+	ctx, span := otel.Tracer("cart").Start(ctx, "product_breaker_get_product_info")
+	defer span.End()
+
+	result, err := c.breaker.Execute(func() (interface{}, error) {
+		return c.client.GetProductInfo(ctx, sku)
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	productInfo, _ := result.(*domain.Product)
+
+	return productInfo, nil
+}
+
+func (c *Client) GetProductsInfo(ctx context.Context, skus []uint32) (map[uint32]*domain.Product, error) {
+	ctx, span := otel.Tracer("cart").Start(ctx, "product_breaker_get_products_info")
+	defer span.End()
+
+	result, err := c.breaker.Execute(func() (interface{}, error) {
+		return c.client.GetProductsInfo(ctx, skus)
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	products, _ := result.(map[uint32]*domain.Product)
+
+	return products, nil
+}
+
+// isSuccessful reports errors which say nothing about the product service health:
+// an unknown sku is a valid answer, a rejected request is the caller's fault
+// and a cancelled request was abandoned by the caller.
+func isSuccessful(err error) bool {
+	return err == nil || errors.Is(err, product.ErrProductNotFound) || errors.Is(err, product.ErrBadRequest) ||
+		errors.Is(err, context.Canceled)
+}
+
+func wrapError(err error) error {
+	if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+		return fmt.Errorf("%w: %w", ErrCircuitOpen, err)
+	}
+
+	return err
+}
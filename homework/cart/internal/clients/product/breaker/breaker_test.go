@@ -0,0 +1,121 @@
+package breaker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+
+	"route256/cart/internal/clients/product"
+	"route256/cart/internal/clients/product/breaker/mock"
+	"route256/cart/internal/domain"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestGetProductInfoOpensAfterConsecutiveFailures(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := minimock.NewController(t)
+	clientMock := mock.NewProductClientMock(ctrl)
+	clientMock.GetProductInfoMock.Times(3).Return(nil, fmt.Errorf("test error"))
+
+	client := New(clientMock, 3, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		_, err := client.GetProductInfo(ctx, 100)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrCircuitOpen)
+	}
+
+	require.Equal(t, gobreaker.StateOpen, client.breaker.State())
+
+	// открытый breaker не обращается к сервису
+	_, err := client.GetProductInfo(ctx, 100)
+	require.ErrorIs(t, err, ErrCircuitOpen)
+
+	_, err = client.GetProductsInfo(ctx, []uint32{100})
+	require.ErrorIs(t, err, ErrCircuitOpen)
+}
+
+func TestGetProductInfoNotFoundDoesNotOpen(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := minimock.NewController(t)
+	clientMock := mock.NewProductClientMock(ctrl)
+	clientMock.GetProductInfoMock.Times(5).Return(nil, fmt.Errorf("%w", product.ErrProductNotFound))
+
+	client := New(clientMock, 2, time.Minute)
+
+	for i := 0; i < 5; i++ {
+		_, err := client.GetProductInfo(ctx, 100)
+		require.ErrorIs(t, err, product.ErrProductNotFound)
+	}
+
+	require.Equal(t, gobreaker.StateClosed, client.breaker.State())
+}
+
+func TestGetProductInfoBadRequestDoesNotOpen(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := minimock.NewController(t)
+	clientMock := mock.NewProductClientMock(ctrl)
+	clientMock.GetProductInfoMock.Times(5).Return(nil, fmt.Errorf("%w", product.ErrBadRequest))
+
+	client := New(clientMock, 2, time.Minute)
+
+	for i := 0; i < 5; i++ {
+		_, err := client.GetProductInfo(ctx, 100)
+		require.ErrorIs(t, err, product.ErrBadRequest)
+	}
+
+	require.Equal(t, gobreaker.StateClosed, client.breaker.State())
+}
+
+func TestGetProductsInfoClosesAfterSuccessfulTrial(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := minimock.NewController(t)
+	clientMock := mock.NewProductClientMock(ctrl)
+
+	calls := 0
+	clientMock.GetProductsInfoMock.Set(func(_ context.Context, _ []uint32) (map[uint32]*domain.Product, error) {
+		calls++
+		if calls == 1 {
+			return nil, fmt.Errorf("test error")
+		}
+
+		return map[uint32]*domain.Product{100: {Name: "Книга", Price: 300}}, nil
+	})
+
+	client := New(clientMock, 1, 10*time.Millisecond)
+
+	_, err := client.GetProductsInfo(ctx, []uint32{100})
+	require.Error(t, err)
+	require.Equal(t, gobreaker.StateOpen, client.breaker.State())
+
+	require.Eventually(t, func() bool {
+		return client.breaker.State() == gobreaker.StateHalfOpen
+	}, time.Second, 5*time.Millisecond)
+
+	products, err := client.GetProductsInfo(ctx, []uint32{100})
+	require.NoError(t, err)
+	require.Equal(t, map[uint32]*domain.Product{100: {Name: "Книга", Price: 300}}, products)
+	require.Equal(t, gobreaker.StateClosed, client.breaker.State())
+	require.Equal(t, 2, calls)
+}
@@ -0,0 +1,164 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/sync/singleflight"
+
+	"route256/cart/internal/domain"
+	"route256/cart/pkg/prometheus"
+)
+
+const (
+	resultHit  = "hit"
+	resultMiss = "miss"
+)
+
+// sharedFetchTimeout bounds the lookup shared by the collapsed callers, it outlives the request that started it
+const sharedFetchTimeout = 10 * time.Second
+
+type (
+	productClient interface {
+		GetProductInfo(ctx context.Context, sku uint32) (*domain.Product, error)
+		GetProductsInfo(ctx context.Context, skus []uint32) (map[uint32]*domain.Product, error)
+	}
+
+	entry struct {
+		product   domain.Product
+		expiresAt time.Time
+	}
+
+	// Client caches products of the wrapped client in LRU with ttl,
+	// concurrent lookups of the same missing sku are collapsed into a single request.
+	Client struct {
+		client productClient
+		lru    *lru.Cache[uint32, entry]
+		ttl    time.Duration
+		now    func() time.Time
+		group  singleflight.Group
+	}
+)
+
+func New(client productClient, size int, ttl time.Duration) (*Client, error) {
+	cache, err := lru.New[uint32, entry](size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create product cache: %w", err)
+	}
+
+	return &Client{
+		client: client,
+		lru:    cache,
+		ttl:    ttl,
+		now:    time.Now,
+	}, nil
+}
+
+func (c *Client) GetProductInfo(ctx context.Context, sku uint32) (*domain.Product, error) {
+	ctx, span := otel.Tracer("cart").Start(ctx, "product_cache_get_product_info")
+	defer span.End()
+
+	// Протухшая запись считается промахом и перезаписывается свежим ответом, фоновая очистка не нужна
+	if cached, ok := c.lru.Get(sku); ok && c.now().Before(cached.expiresAt) {
+		prometheus.IncProductCacheRequestsTotalCounter(resultHit)
+
+		return &cached.product, nil
+	}
+
+	prometheus.IncProductCacheRequestsTotalCounter(resultMiss)
+
+	resultCh := c.group.DoChan(strconv.FormatUint(uint64(sku), 10), func() (interface{}, error) {
+		// Отмена запроса, начавшего загрузку, не должна возвращать ошибку остальным ожидающим
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sharedFetchTimeout)
+		defer cancel()
+
+		product, err := c.client.GetProductInfo(ctx, sku)
+		if err != nil || product == nil {
+			return product, err
+		}
+
+		c.lru.Add(sku, entry{
+			product:   *product,
+			expiresAt: c.now().Add(c.ttl),
+		})
+
+		return product, nil
+	})
+
+	var result singleflight.Result
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result = <-resultCh:
+	}
+
+	if result.Err != nil {
+		return nil, result.Err
+	}
+
+	product, _ := result.Val.(*domain.Product)
+	if product == nil {
+		return nil, nil
+	}
+
+	// Копия, чтобы вызывающие не меняли общий для singleflight результат
+	productCopy := *product
+
+	return &productCopy, nil
+}
+
+// GetProductsInfo returns cached products and requests only the missing ones in a single batch.
+func (c *Client) GetProductsInfo(ctx context.Context, skus []uint32) (map[uint32]*domain.Product, error) {
+	ctx, span := otel.Tracer("cart").Start(ctx, "product_cache_get_products_info")
+	defer span.End()
+
+	products := make(map[uint32]*domain.Product, len(skus))
+	missingSKUs := make([]uint32, 0, len(skus))
+
+	now := c.now()
+
+	for _, sku := range skus {
+		if cached, ok := c.lru.Get(sku); ok && now.Before(cached.expiresAt) {
+			prometheus.IncProductCacheRequestsTotalCounter(resultHit)
+
+			products[sku] = &cached.product
+
+			continue
+		}
+
+		prometheus.IncProductCacheRequestsTotalCounter(resultMiss)
+
+		missingSKUs = append(missingSKUs, sku)
+	}
+
+	if len(missingSKUs) == 0 {
+		return products, nil
+	}
+
+	fetched, err := c.client.GetProductsInfo(ctx, missingSKUs)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := c.now().Add(c.ttl)
+
+	for sku, product := range fetched {
+		if product == nil {
+			continue
+		}
+
+		c.lru.Add(sku, entry{
+			product:   *product,
+			expiresAt: expiresAt,
+		})
+
+		products[sku] = product
+	}
+
+	return products, nil
+}
@@ -0,0 +1,222 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+
+	"route256/cart/internal/clients/product/cache/mock"
+	"route256/cart/internal/domain"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestGetProductInfoCachesProduct(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := minimock.NewController(t)
+	clientMock := mock.NewProductClientMock(ctrl)
+	clientMock.GetProductInfoMock.Times(1).ExpectSkuParam2(100).Return(&domain.Product{
+		Name:  "Книга",
+		Price: 300,
+	}, nil)
+
+	client, err := New(clientMock, 10, time.Minute)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		product, err := client.GetProductInfo(ctx, 100)
+		require.NoError(t, err)
+		require.Equal(t, &domain.Product{Name: "Книга", Price: 300}, product)
+	}
+}
+
+func TestGetProductInfoExpiresProduct(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := minimock.NewController(t)
+	clientMock := mock.NewProductClientMock(ctrl)
+	clientMock.GetProductInfoMock.Times(2).ExpectSkuParam2(100).Return(&domain.Product{
+		Name:  "Книга",
+		Price: 300,
+	}, nil)
+
+	client, err := New(clientMock, 10, time.Minute)
+	require.NoError(t, err)
+
+	now := time.Now()
+	client.now = func() time.Time { return now }
+
+	_, err = client.GetProductInfo(ctx, 100)
+	require.NoError(t, err)
+
+	now = now.Add(2 * time.Minute)
+
+	_, err = client.GetProductInfo(ctx, 100)
+	require.NoError(t, err)
+}
+
+func TestGetProductInfoDoesNotCacheErrors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := minimock.NewController(t)
+	clientMock := mock.NewProductClientMock(ctrl)
+	clientMock.GetProductInfoMock.Times(2).ExpectSkuParam2(100).Return(nil, fmt.Errorf("test error"))
+
+	client, err := New(clientMock, 10, time.Minute)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = client.GetProductInfo(ctx, 100)
+		require.Error(t, err)
+	}
+}
+
+func TestGetProductInfoCollapsesConcurrentLookups(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var calls atomic.Int64
+
+	release := make(chan struct{})
+
+	ctrl := minimock.NewController(t)
+	clientMock := mock.NewProductClientMock(ctrl)
+	clientMock.GetProductInfoMock.Set(func(_ context.Context, _ uint32) (*domain.Product, error) {
+		calls.Add(1)
+		<-release
+
+		return &domain.Product{Name: "Книга", Price: 300}, nil
+	})
+
+	client, err := New(clientMock, 10, time.Minute)
+	require.NoError(t, err)
+
+	const goroutines = 10
+
+	products := make([]*domain.Product, goroutines)
+	errs := make([]error, goroutines)
+
+	wg := sync.WaitGroup{}
+	wg.Add(goroutines)
+
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+
+			products[i], errs[i] = client.GetProductInfo(ctx, 100)
+		}()
+	}
+
+	// Даём горутинам дойти до singleflight, прежде чем отпустить запрос
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int64(1), calls.Load())
+
+	for i := 0; i < goroutines; i++ {
+		require.NoError(t, errs[i])
+		require.Equal(t, "Книга", products[i].Name)
+	}
+}
+
+func TestGetProductsInfoRequestsOnlyMissing(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := minimock.NewController(t)
+	clientMock := mock.NewProductClientMock(ctrl)
+	clientMock.GetProductInfoMock.ExpectSkuParam2(100).Return(&domain.Product{
+		Name:  "Книга",
+		Price: 300,
+	}, nil)
+	clientMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{200, 300}).Return(map[uint32]*domain.Product{
+		200: {Name: "Ручка", Price: 50},
+	}, nil)
+
+	client, err := New(clientMock, 10, time.Minute)
+	require.NoError(t, err)
+
+	_, err = client.GetProductInfo(ctx, 100)
+	require.NoError(t, err)
+
+	products, err := client.GetProductsInfo(ctx, []uint32{100, 200, 300})
+	require.NoError(t, err)
+	require.Equal(t, map[uint32]*domain.Product{
+		100: {Name: "Книга", Price: 300},
+		200: {Name: "Ручка", Price: 50},
+	}, products)
+}
+
+func TestGetProductInfoCancelledFirstCallerDoesNotFailWaiters(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var fetchCtx context.Context
+
+	ctrl := minimock.NewController(t)
+	clientMock := mock.NewProductClientMock(ctrl)
+	clientMock.GetProductInfoMock.Set(func(ctx context.Context, _ uint32) (*domain.Product, error) {
+		fetchCtx = ctx
+		close(started)
+		<-release
+
+		return &domain.Product{Name: "Книга", Price: 300}, ctx.Err()
+	})
+
+	client, err := New(clientMock, 10, time.Minute)
+	require.NoError(t, err)
+
+	firstCtx, cancel := context.WithCancel(context.Background())
+
+	firstErr := make(chan error)
+	go func() {
+		_, err := client.GetProductInfo(firstCtx, 100)
+		firstErr <- err
+	}()
+
+	<-started
+	cancel()
+
+	// Первый вызов отменён, но общая загрузка продолжает работу
+	require.ErrorIs(t, <-firstErr, context.Canceled)
+	require.NoError(t, fetchCtx.Err())
+
+	var (
+		product   *domain.Product
+		waiterErr error
+	)
+
+	waiterDone := make(chan struct{})
+	go func() {
+		defer close(waiterDone)
+
+		product, waiterErr = client.GetProductInfo(context.Background(), 100)
+	}()
+
+	close(release)
+	<-waiterDone
+
+	require.NoError(t, waiterErr)
+	require.Equal(t, &domain.Product{Name: "Книга", Price: 300}, product)
+}
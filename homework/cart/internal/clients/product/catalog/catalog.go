@@ -0,0 +1,73 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"route256/cart/internal/clients/product"
+	"route256/cart/internal/domain"
+)
+
+type (
+	item struct {
+		SKU   uint32 `json:"sku"`
+		Name  string `json:"name"`
+		Price uint32 `json:"price"`
+	}
+
+	// Catalog serves products from a JSON file instead of the product service,
+	// the file holds an array of {"sku", "name", "price"} objects.
+	Catalog struct {
+		products map[uint32]domain.Product
+	}
+)
+
+func Load(path string) (*Catalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read product catalog: %w", err)
+	}
+
+	var items []item
+	if err = json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("failed to decode product catalog %q: %w", path, err)
+	}
+
+	products := make(map[uint32]domain.Product, len(items))
+	for _, i := range items {
+		if _, ok := products[i.SKU]; ok {
+			return nil, fmt.Errorf("product catalog %q has duplicate sku %d", path, i.SKU)
+		}
+
+		products[i.SKU] = domain.Product{
+			Name:  i.Name,
+			Price: i.Price,
+		}
+	}
+
+	return &Catalog{products: products}, nil
+}
+
+func (c *Catalog) GetProductInfo(_ context.Context, sku uint32) (*domain.Product, error) {
+	p, ok := c.products[sku]
+	if !ok {
+		return nil, fmt.Errorf("%w: sku %d", product.ErrProductNotFound, sku)
+	}
+
+	return &p, nil
+}
+
+// GetProductsInfo returns products by skus, the skus absent in the catalog are absent in the result.
+func (c *Catalog) GetProductsInfo(_ context.Context, skus []uint32) (map[uint32]*domain.Product, error) {
+	products := make(map[uint32]*domain.Product, len(skus))
+
+	for _, sku := range skus {
+		if p, ok := c.products[sku]; ok {
+			products[sku] = &p
+		}
+	}
+
+	return products, nil
+}
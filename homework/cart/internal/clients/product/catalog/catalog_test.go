@@ -0,0 +1,85 @@
+package catalog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"route256/cart/internal/clients/product"
+	"route256/cart/internal/domain"
+)
+
+func writeCatalog(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "products.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestCatalog(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	catalog, err := Load(writeCatalog(t, `[
+		{"sku": 100, "name": "Книга", "price": 300},
+		{"sku": 200, "name": "Ручка", "price": 50}
+	]`))
+	require.NoError(t, err)
+
+	p, err := catalog.GetProductInfo(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, &domain.Product{Name: "Книга", Price: 300}, p)
+
+	_, err = catalog.GetProductInfo(ctx, 300)
+	require.ErrorIs(t, err, product.ErrProductNotFound)
+
+	products, err := catalog.GetProductsInfo(ctx, []uint32{100, 200, 300})
+	require.NoError(t, err)
+	require.Equal(t, map[uint32]*domain.Product{
+		100: {Name: "Книга", Price: 300},
+		200: {Name: "Ручка", Price: 50},
+	}, products)
+}
+
+func TestLoadErrorsTable(t *testing.T) {
+	t.Parallel()
+
+	type data struct {
+		name    string
+		content string
+	}
+
+	testData := []data{
+		{name: "malformed json", content: `[{"sku": 100`},
+		{name: "duplicate sku", content: `[{"sku": 100, "name": "a"}, {"sku": 100, "name": "b"}]`},
+	}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Load(writeCatalog(t, tt.content))
+			require.Error(t, err)
+		})
+	}
+
+	_, err := Load(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
+
+func TestLoadE2EFixture(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := Load("../../../../e2e/testdata/products.json")
+	require.NoError(t, err)
+
+	_, err = catalog.GetProductInfo(context.Background(), 1076963)
+	require.NoError(t, err)
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -18,10 +19,32 @@ import (
 	"route256/cart/pkg/prometheus"
 )
 
-type Client struct {
-	token    string
-	basePath string
-}
+type (
+	limiter interface {
+		Wait(ctx context.Context) error
+	}
+
+	Client struct {
+		token      string
+		basePath   string
+		httpClient *http.Client
+		limiter    limiter
+		now        func() time.Time
+
+		// batchUnsupportedUntil is the unix time in nanoseconds until which the batch endpoint is not requested,
+		// it is set once the product service responded that it has no batch endpoint
+		batchUnsupportedUntil *atomic.Int64
+	}
+
+	// Options configures the client, nil Limiter leaves the single product requests of the batch fallback unlimited.
+	// MaxRetries is the number of retries after the first attempt, zero delays use the defaults of middleware.RetryTransport.
+	Options struct {
+		Limiter        limiter
+		MaxRetries     int
+		RetryBaseDelay time.Duration
+		RetryMaxDelay  time.Duration
+	}
+)
 
 type GetProductRequest struct {
 	Token string `json:"token,omitempty"`
@@ -40,9 +63,7 @@ type GetProductErrorResponse struct {
 
 const handlerName = "get_product"
 
-var ErrGetProductInfo = errors.New("ProductService.GetProductInfo failed: ")
-
-func New(basePath, token string) (*Client, error) {
+func New(basePath, token string, opts Options) (*Client, error) {
 	if token == "" {
 		return nil, errors.New("product service has empty auth token")
 	}
@@ -50,6 +71,16 @@ func New(basePath, token string) (*Client, error) {
 	return &Client{
 		token:    token,
 		basePath: basePath,
+		httpClient: &http.Client{
+			Transport: &middleware.RetryTransport{
+				MaxRetries: opts.MaxRetries,
+				BaseDelay:  opts.RetryBaseDelay,
+				MaxDelay:   opts.RetryMaxDelay,
+			},
+		},
+		limiter:               opts.Limiter,
+		now:                   time.Now,
+		batchUnsupportedUntil: &atomic.Int64{},
 	}, nil
 }
 
@@ -81,43 +112,34 @@ func (c Client) GetProductInfo(ctx context.Context, sku uint32) (*domain.Product
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
 
-	client := &http.Client{
-		Transport: &middleware.RetryTransport{MaxRetries: 3},
-	}
-
 	prometheus.IncExternalRequestsTotalCounter("product", "get_product_info")
 
-	httpResponse, err := client.Do(httpRequest)
-	prometheus.IncExternalResponseStatusTotalCounter("POST /get_product", strconv.Itoa(httpResponse.StatusCode))
-
+	httpResponse, err := c.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
+		prometheus.IncExternalResponseStatusTotalCounter("POST /get_product", "error")
+
+		return nil, fmt.Errorf("%w: failed to execute HTTP request: %w", ErrUpstreamUnavailable, err)
 	}
 
+	prometheus.IncExternalResponseStatusTotalCounter("POST /get_product", strconv.Itoa(httpResponse.StatusCode))
+
 	defer func() {
 		_ = httpResponse.Body.Close()
 	}()
 
-	if httpResponse.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("product not found")
-	}
-
 	if httpResponse.StatusCode != http.StatusOK {
+		// Тело ошибки может быть не JSON (например, от балансировщика), поэтому статус важнее сообщения
 		response := &GetProductErrorResponse{}
-		err = json.NewDecoder(httpResponse.Body).Decode(response)
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to decode error response: %w", err)
-		}
+		_ = json.NewDecoder(httpResponse.Body).Decode(response)
 
-		return nil, fmt.Errorf("HTTP request responded with: %d , message: %s", httpResponse.StatusCode, response.Message)
+		return nil, statusError(httpResponse.StatusCode, response.Message)
 	}
 
 	response := &GetProductResponse{}
 	err = json.NewDecoder(httpResponse.Body).Decode(response)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+		return nil, fmt.Errorf("%w: failed to decode response: %w", ErrMalformedResponse, err)
 	}
 
 	return &domain.Product{
@@ -0,0 +1,133 @@
+package product
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProductInfoClassifiesErrorsTable(t *testing.T) {
+	t.Parallel()
+
+	type data struct {
+		name       string
+		statusCode int
+		body       string
+		wantErr    error
+	}
+
+	testData := []data{
+		{
+			name:       "not found",
+			statusCode: http.StatusNotFound,
+			body:       `{"code":5,"message":"sku not found"}`,
+			wantErr:    ErrProductNotFound,
+		},
+		{
+			name:       "unauthorized",
+			statusCode: http.StatusUnauthorized,
+			body:       `{"code":16,"message":"bad token"}`,
+			wantErr:    ErrUnauthorized,
+		},
+		{
+			name:       "rate limited",
+			statusCode: enhanceYourCalmStatus,
+			body:       `{"code":8,"message":"too many requests"}`,
+			wantErr:    ErrRateLimited,
+		},
+		{
+			name:       "upstream unavailable with non json body",
+			statusCode: http.StatusBadGateway,
+			body:       `<html>bad gateway</html>`,
+			wantErr:    ErrUpstreamUnavailable,
+		},
+		{
+			name:       "bad request",
+			statusCode: http.StatusBadRequest,
+			body:       `{"code":3,"message":"invalid sku"}`,
+			wantErr:    ErrBadRequest,
+		},
+		{
+			name:       "unprocessable entity",
+			statusCode: http.StatusUnprocessableEntity,
+			body:       `<html>unprocessable</html>`,
+			wantErr:    ErrBadRequest,
+		},
+		{
+			name:       "malformed response",
+			statusCode: http.StatusOK,
+			body:       `{"name":`,
+			wantErr:    ErrMalformedResponse,
+		},
+	}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				// ретраи без задержки, чтобы не замедлять тест
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client, err := New(server.URL, "token", Options{})
+			require.NoError(t, err)
+
+			_, err = client.GetProductInfo(context.Background(), 100)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGetProductInfoNetworkError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	client, err := New(server.URL, "token", Options{})
+	require.NoError(t, err)
+
+	require.NotPanics(t, func() {
+		_, err = client.GetProductInfo(context.Background(), 100)
+	})
+	require.ErrorIs(t, err, ErrUpstreamUnavailable)
+}
+
+func TestGetProductInfoRetriesByOptions(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"name":"Книга","price":300}`))
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token", Options{
+		MaxRetries:     1,
+		RetryBaseDelay: time.Millisecond,
+		RetryMaxDelay:  time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	product, err := client.GetProductInfo(context.Background(), 100)
+	require.NoError(t, err)
+	require.Equal(t, "Книга", product.Name)
+	require.Equal(t, int64(2), calls.Load())
+}
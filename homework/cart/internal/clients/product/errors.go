@@ -0,0 +1,42 @@
+package product
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const enhanceYourCalmStatus = 420
+
+var (
+	ErrGetProductInfo      = errors.New("ProductService.GetProductInfo failed: ")
+	ErrProductNotFound     = errors.New("product not found")
+	ErrUnauthorized        = errors.New("product service rejected the token")
+	ErrRateLimited         = errors.New("product service rate limit exceeded")
+	ErrUpstreamUnavailable = errors.New("product service is unavailable")
+	ErrMalformedResponse   = errors.New("product service returned malformed response")
+	ErrBadRequest          = errors.New("product service rejected the request")
+)
+
+// statusError classifies unsuccessful response of the product service by its status code.
+func statusError(statusCode int, message string) error {
+	var kind error
+
+	switch {
+	case statusCode == http.StatusNotFound:
+		kind = ErrProductNotFound
+	case statusCode == http.StatusUnauthorized, statusCode == http.StatusForbidden:
+		kind = ErrUnauthorized
+	case statusCode == enhanceYourCalmStatus, statusCode == http.StatusTooManyRequests:
+		kind = ErrRateLimited
+	case statusCode >= http.StatusInternalServerError:
+		kind = ErrUpstreamUnavailable
+	case statusCode >= http.StatusBadRequest:
+		kind = ErrBadRequest
+	default:
+		// Неожиданный успешный или redirect статус, тело под него не разобрать
+		kind = ErrMalformedResponse
+	}
+
+	return fmt.Errorf("%w: HTTP request responded with: %d , message: %s", kind, statusCode, message)
+}
@@ -0,0 +1,184 @@
+package product
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+
+	"route256/cart/internal/app/errgroup"
+	"route256/cart/internal/domain"
+	"route256/cart/pkg/logger"
+	"route256/cart/pkg/prometheus"
+)
+
+type GetProductsRequest struct {
+	Token string   `json:"token,omitempty"`
+	SKUs  []uint32 `json:"skus,omitempty"`
+}
+
+type GetProductsResponse struct {
+	Products []GetProductsResponseItem `json:"products,omitempty"`
+}
+
+type GetProductsResponseItem struct {
+	SKU   uint32 `json:"sku,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Price uint32 `json:"price,omitempty"`
+}
+
+const (
+	batchHandlerName = "get_products"
+
+	// maxConcurrentRequests bounds single requests when the product service has no batch endpoint
+	maxConcurrentRequests = 5
+
+	// batchReprobeInterval is how long the single requests are used before the batch endpoint is requested again
+	batchReprobeInterval = 5 * time.Minute
+)
+
+var errBatchUnsupported = errors.New("product service does not support batch lookup")
+
+// GetProductsInfo returns products by skus in a single request to the batch endpoint, the skus unknown
+// to the product service are absent in the result. If the product service has no batch endpoint,
+// the products are requested one by one with bounded concurrency under the rate limiter
+// and the batch endpoint is probed again after batchReprobeInterval.
+func (c Client) GetProductsInfo(ctx context.Context, skus []uint32) (map[uint32]*domain.Product, error) {
+	ctx, span := otel.Tracer("cart").Start(ctx, "product_client_get_products_info")
+	defer span.End()
+
+	if len(skus) == 0 {
+		return map[uint32]*domain.Product{}, nil
+	}
+
+	if c.now().UnixNano() >= c.batchUnsupportedUntil.Load() {
+		products, err := c.getProductsBatch(ctx, skus)
+		if !errors.Is(err, errBatchUnsupported) {
+			return products, err
+		}
+
+		c.batchUnsupportedUntil.Store(c.now().Add(batchReprobeInterval).UnixNano())
+		logger.Infow(ctx, "falling back to single product requests", "error", err)
+	}
+
+	return c.getProductsConcurrently(ctx, skus)
+}
+
+func (c Client) getProductsBatch(ctx context.Context, skus []uint32) (map[uint32]*domain.Product, error) {
+	defer func(createdAt time.Time) {
+		prometheus.ObserveExternalRequestsDurationHistogram(createdAt, "product", "get_products_info")
+	}(time.Now())
+
+	request := GetProductsRequest{
+		Token: c.token,
+		SKUs:  skus,
+	}
+	data, err := json.Marshal(request)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request %w", err)
+	}
+
+	path, err := url.JoinPath(c.basePath, batchHandlerName)
+	if err != nil {
+		return nil, fmt.Errorf("incorrect base basePath for %q: %w", batchHandlerName, err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, path, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+
+	prometheus.IncExternalRequestsTotalCounter("product", "get_products_info")
+
+	httpResponse, err := c.httpClient.Do(httpRequest)
+	if err != nil {
+		prometheus.IncExternalResponseStatusTotalCounter("POST /get_products", "error")
+
+		return nil, fmt.Errorf("%w: failed to execute HTTP request: %w", ErrUpstreamUnavailable, err)
+	}
+
+	prometheus.IncExternalResponseStatusTotalCounter("POST /get_products", strconv.Itoa(httpResponse.StatusCode))
+
+	defer func() {
+		_ = httpResponse.Body.Close()
+	}()
+
+	switch httpResponse.StatusCode {
+	case http.StatusOK:
+	// Неизвестные товары батч-эндпоинт пропускает в ответе 200, поэтому 404 означает отсутствие самого маршрута.
+	// Ошибочный 404 отключает батч только до повторной проверки через batchReprobeInterval
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return nil, fmt.Errorf("%w: status %d", errBatchUnsupported, httpResponse.StatusCode)
+	default:
+		response := &GetProductErrorResponse{}
+		_ = json.NewDecoder(httpResponse.Body).Decode(response)
+
+		return nil, statusError(httpResponse.StatusCode, response.Message)
+	}
+
+	response := &GetProductsResponse{}
+	err = json.NewDecoder(httpResponse.Body).Decode(response)
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode response: %w", ErrMalformedResponse, err)
+	}
+
+	products := make(map[uint32]*domain.Product, len(response.Products))
+	for _, product := range response.Products {
+		products[product.SKU] = &domain.Product{
+			Name:  product.Name,
+			Price: product.Price,
+		}
+	}
+
+	return products, nil
+}
+
+func (c Client) getProductsConcurrently(ctx context.Context, skus []uint32) (map[uint32]*domain.Product, error) {
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(maxConcurrentRequests)
+
+	products := make(map[uint32]*domain.Product, len(skus))
+	mx := sync.Mutex{}
+
+	for _, sku := range skus {
+		eg.Go(func() error {
+			if c.limiter != nil {
+				if err := c.limiter.Wait(ctx); err != nil {
+					return fmt.Errorf("rate limiter error: %w", err)
+				}
+			}
+
+			product, err := c.GetProductInfo(ctx, sku)
+			// Неизвестный товар не попадает в результат, как и в ответе батч-эндпоинта
+			if errors.Is(err, ErrProductNotFound) {
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("sku %d: %w", sku, err)
+			}
+
+			mx.Lock()
+			products[sku] = product
+			mx.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
@@ -0,0 +1,195 @@
+package product
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"route256/cart/internal/domain"
+)
+
+func TestGetProductsInfoUsesBatchEndpoint(t *testing.T) {
+	t.Parallel()
+
+	var singleCalls atomic.Int64
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /get_products", func(w http.ResponseWriter, r *http.Request) {
+		request := GetProductsRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		response := GetProductsResponse{}
+		for _, sku := range request.SKUs {
+			if sku == 300 {
+				continue
+			}
+
+			response.Products = append(response.Products, GetProductsResponseItem{SKU: sku, Name: "Книга", Price: sku})
+		}
+
+		_ = json.NewEncoder(w).Encode(response)
+	})
+	mux.HandleFunc("POST /get_product", func(w http.ResponseWriter, _ *http.Request) {
+		singleCalls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "token", Options{})
+	require.NoError(t, err)
+
+	products, err := client.GetProductsInfo(context.Background(), []uint32{100, 200, 300})
+	require.NoError(t, err)
+	require.Equal(t, map[uint32]*domain.Product{
+		100: {Name: "Книга", Price: 100},
+		200: {Name: "Книга", Price: 200},
+	}, products)
+	require.Zero(t, singleCalls.Load())
+}
+
+func TestGetProductsInfoFallsBackToSingleRequests(t *testing.T) {
+	t.Parallel()
+
+	var batchCalls atomic.Int64
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /get_products", func(w http.ResponseWriter, _ *http.Request) {
+		batchCalls.Add(1)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	})
+	mux.HandleFunc("POST /get_product", func(w http.ResponseWriter, r *http.Request) {
+		request := GetProductRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		if request.SKU == 300 {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(GetProductResponse{Name: "Книга", Price: request.SKU})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var waits atomic.Int64
+
+	client, err := New(server.URL, "token", Options{Limiter: limiterFunc(func(context.Context) error {
+		waits.Add(1)
+
+		return nil
+	})})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		// Неизвестный товар отсутствует в результате, как и в ответе батч-эндпоинта
+		products, err := client.GetProductsInfo(context.Background(), []uint32{100, 200, 300})
+		require.NoError(t, err)
+		require.Equal(t, map[uint32]*domain.Product{
+			100: {Name: "Книга", Price: 100},
+			200: {Name: "Книга", Price: 200},
+		}, products)
+	}
+
+	// После первого отказа батч-эндпоинт не запрашивается до повторной проверки
+	require.Equal(t, int64(1), batchCalls.Load())
+	require.Equal(t, int64(6), waits.Load())
+}
+
+func TestGetProductsInfoReprobesBatchEndpoint(t *testing.T) {
+	t.Parallel()
+
+	var (
+		batchCalls atomic.Int64
+		supported  atomic.Bool
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /get_products", func(w http.ResponseWriter, _ *http.Request) {
+		batchCalls.Add(1)
+
+		if !supported.Load() {
+			w.WriteHeader(http.StatusNotImplemented)
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(GetProductsResponse{
+			Products: []GetProductsResponseItem{{SKU: 100, Name: "Книга", Price: 100}},
+		})
+	})
+	mux.HandleFunc("POST /get_product", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(GetProductResponse{Name: "Книга", Price: 100})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "token", Options{})
+	require.NoError(t, err)
+
+	now := time.Now()
+	client.now = func() time.Time { return now }
+
+	_, err = client.GetProductsInfo(context.Background(), []uint32{100})
+	require.NoError(t, err)
+
+	supported.Store(true)
+
+	_, err = client.GetProductsInfo(context.Background(), []uint32{100})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), batchCalls.Load())
+
+	now = now.Add(batchReprobeInterval)
+
+	products, err := client.GetProductsInfo(context.Background(), []uint32{100})
+	require.NoError(t, err)
+	require.Equal(t, map[uint32]*domain.Product{100: {Name: "Книга", Price: 100}}, products)
+	require.Equal(t, int64(2), batchCalls.Load())
+}
+
+func TestGetProductsInfoFallsBackWithoutBatchRoute(t *testing.T) {
+	t.Parallel()
+
+	// Сервис без батч-маршрута: ServeMux отвечает 404 на неизвестный путь
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /get_product", func(w http.ResponseWriter, r *http.Request) {
+		request := GetProductRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		if request.SKU == 300 {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(GetProductResponse{Name: "Книга", Price: request.SKU})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "token", Options{})
+	require.NoError(t, err)
+
+	products, err := client.GetProductsInfo(context.Background(), []uint32{100, 300})
+	require.NoError(t, err)
+	require.Equal(t, map[uint32]*domain.Product{
+		100: {Name: "Книга", Price: 100},
+	}, products)
+}
+
+type limiterFunc func(ctx context.Context) error
+
+func (f limiterFunc) Wait(ctx context.Context) error {
+	return f(ctx)
+}
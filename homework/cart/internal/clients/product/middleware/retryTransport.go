@@ -1,32 +1,190 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math/rand/v2"
 	"net/http"
+	"strconv"
+	"time"
+
+	"route256/cart/pkg/prometheus"
+)
+
+const (
+	enhanceYourCalmStatus = 420
+
+	defaultBaseDelay = 100 * time.Millisecond
+	defaultMaxDelay  = 5 * time.Second
 )
 
+// RetryTransport retries requests failed with a network error, a rate limit status or a 5xx status.
+// Delays between attempts grow exponentially with jitter, the Retry-After header of the response takes precedence.
+// A request with a body is retried only if it can be rewound with GetBody.
 type RetryTransport struct {
-	Transport  http.RoundTripper
+	Transport http.RoundTripper
+
+	// MaxRetries is the number of attempts after the first one, so a request is sent at most MaxRetries+1 times.
 	MaxRetries int
+	// BaseDelay is the delay before the first retry, it doubles with every next attempt
+	BaseDelay time.Duration
+	// MaxDelay limits both the backoff delay and the Retry-After delay
+	MaxDelay time.Duration
 }
 
 func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	if t.Transport == nil {
-		t.Transport = http.DefaultTransport
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
 	}
 
-	const enhancementYourCalmStatus = 420
+	ctx := req.Context()
 
-	for i := 0; i < t.MaxRetries; i++ {
-		res, err := t.Transport.RoundTrip(req)
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := rewindRequest(req, attempt)
 		if err != nil {
-			return nil, fmt.Errorf("RoundTrip failed: %w", err)
+			return nil, err
 		}
 
-		if res.StatusCode != enhancementYourCalmStatus && res.StatusCode != http.StatusTooManyRequests {
+		res, err := transport.RoundTrip(attemptReq)
+
+		reason, retryable := retryReason(ctx, res, err)
+		if !retryable || attempt >= t.MaxRetries || !canRewind(req) {
+			if err != nil {
+				return nil, fmt.Errorf("RoundTrip failed: %w", err)
+			}
+
 			return res, nil
 		}
+
+		delay := t.backoff(attempt)
+		if res != nil {
+			if retryAfter, ok := ParseRetryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+				delay = min(retryAfter, t.maxDelay())
+			}
+		}
+
+		// Не ждём ретрая, который всё равно не успеет до дедлайна запроса
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			if err != nil {
+				return nil, fmt.Errorf("RoundTrip failed: %w", err)
+			}
+
+			return res, nil
+		}
+
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+
+		prometheus.IncExternalRequestsRetriesTotalCounter(req.URL.Path, reason)
+
+		if err = sleep(ctx, delay); err != nil {
+			return nil, fmt.Errorf("RoundTrip retry cancelled: %w", err)
+		}
+	}
+}
+
+func (t *RetryTransport) backoff(attempt int) time.Duration {
+	baseDelay := t.BaseDelay
+	if baseDelay <= 0 {
+		baseDelay = defaultBaseDelay
+	}
+
+	delay := min(baseDelay<<attempt, t.maxDelay())
+	if delay <= 0 {
+		// сдвиг переполнил Duration
+		delay = t.maxDelay()
 	}
 
-	return nil, fmt.Errorf("max retries exceeded")
+	// equal jitter: половина задержки фиксирована, вторая половина случайна
+	half := delay / 2
+
+	return half + rand.N(half+1)
+}
+
+func (t *RetryTransport) maxDelay() time.Duration {
+	if t.MaxDelay <= 0 {
+		return defaultMaxDelay
+	}
+
+	return t.MaxDelay
+}
+
+// ParseRetryAfter parses the Retry-After header value given either in seconds or as an HTTP date.
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(date.Sub(now), 0), true
+}
+
+func retryReason(ctx context.Context, res *http.Response, err error) (string, bool) {
+	if err != nil {
+		if ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return "", false
+		}
+
+		return "network_error", true
+	}
+
+	switch {
+	case res.StatusCode == enhanceYourCalmStatus, res.StatusCode == http.StatusTooManyRequests:
+		return strconv.Itoa(res.StatusCode), true
+	case res.StatusCode == http.StatusNotImplemented:
+		return "", false
+	case res.StatusCode >= http.StatusInternalServerError:
+		return strconv.Itoa(res.StatusCode), true
+	}
+
+	return "", false
+}
+
+func canRewind(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("failed to rewind request body: %w", err)
+	}
+
+	attemptReq := req.Clone(req.Context())
+	attemptReq.Body = body
+
+	return attemptReq, nil
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
@@ -0,0 +1,253 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func response(statusCode int, header http.Header) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+}
+
+func TestRoundTripTable(t *testing.T) {
+	t.Parallel()
+
+	errNetwork := errors.New("connection reset by peer")
+
+	type data struct {
+		name         string
+		maxRetries   int
+		responses    []func() (*http.Response, error)
+		wantAttempts int
+		wantStatus   int
+		wantErr      error
+	}
+
+	testData := []data{
+		{
+			name:       "success without retries",
+			maxRetries: 3,
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return response(http.StatusOK, nil), nil },
+			},
+			wantAttempts: 1,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:       "rate limit and server errors are retried",
+			maxRetries: 3,
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return response(enhanceYourCalmStatus, nil), nil },
+				func() (*http.Response, error) { return response(http.StatusTooManyRequests, nil), nil },
+				func() (*http.Response, error) { return response(http.StatusBadGateway, nil), nil },
+				func() (*http.Response, error) { return response(http.StatusOK, nil), nil },
+			},
+			wantAttempts: 4,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:       "network error is retried",
+			maxRetries: 3,
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return nil, errNetwork },
+				func() (*http.Response, error) { return response(http.StatusOK, nil), nil },
+			},
+			wantAttempts: 2,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:       "last response is returned when retries are exhausted",
+			maxRetries: 1,
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return response(http.StatusServiceUnavailable, nil), nil },
+				func() (*http.Response, error) { return response(http.StatusServiceUnavailable, nil), nil },
+			},
+			wantAttempts: 2,
+			wantStatus:   http.StatusServiceUnavailable,
+		},
+		{
+			name:       "last network error is returned when retries are exhausted",
+			maxRetries: 1,
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return nil, errNetwork },
+				func() (*http.Response, error) { return nil, errNetwork },
+			},
+			wantAttempts: 2,
+			wantErr:      errNetwork,
+		},
+		{
+			name:       "client errors and not implemented are not retried",
+			maxRetries: 3,
+			responses: []func() (*http.Response, error){
+				func() (*http.Response, error) { return response(http.StatusNotImplemented, nil), nil },
+			},
+			wantAttempts: 1,
+			wantStatus:   http.StatusNotImplemented,
+		},
+	}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			attempts := 0
+			bodies := make([]string, 0, len(tt.responses))
+
+			transport := &RetryTransport{
+				MaxRetries: tt.maxRetries,
+				BaseDelay:  time.Millisecond,
+				MaxDelay:   5 * time.Millisecond,
+				Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					body, err := io.ReadAll(req.Body)
+					require.NoError(t, err)
+
+					bodies = append(bodies, string(body))
+
+					res, err := tt.responses[attempts]()
+					attempts++
+
+					return res, err
+				}),
+			}
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "http://product/get_product", bytes.NewBufferString(`{"sku":1}`))
+			require.NoError(t, err)
+
+			res, err := transport.RoundTrip(req)
+			require.Equal(t, tt.wantAttempts, attempts)
+
+			// тело POST запроса переотправляется при каждой попытке
+			for _, body := range bodies {
+				require.Equal(t, `{"sku":1}`, body)
+			}
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantStatus, res.StatusCode)
+		})
+	}
+}
+
+func TestRoundTripRespectsContextDeadline(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	transport := &RetryTransport{
+		MaxRetries: 3,
+		Transport: roundTripperFunc(func(_ *http.Request) (*http.Response, error) {
+			attempts++
+
+			return response(http.StatusTooManyRequests, http.Header{"Retry-After": []string{"1"}}), nil
+		}),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://product/get_product", http.NoBody)
+	require.NoError(t, err)
+
+	res, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	require.Equal(t, 1, attempts)
+}
+
+func TestRoundTripDoesNotRetryUnrewindableBody(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	transport := &RetryTransport{
+		MaxRetries: 3,
+		BaseDelay:  time.Millisecond,
+		Transport: roundTripperFunc(func(_ *http.Request) (*http.Response, error) {
+			attempts++
+
+			return response(http.StatusServiceUnavailable, nil), nil
+		}),
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "http://product/get_product", io.NopCloser(strings.NewReader("{}")))
+	require.NoError(t, err)
+	require.Nil(t, req.GetBody)
+
+	res, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	require.Equal(t, 1, attempts)
+}
+
+func TestParseRetryAfterTable(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.July, 1, 12, 0, 0, 0, time.UTC)
+
+	type data struct {
+		name      string
+		value     string
+		wantDelay time.Duration
+		wantOk    bool
+	}
+
+	testData := []data{
+		{name: "empty", value: "", wantOk: false},
+		{name: "seconds", value: "3", wantDelay: 3 * time.Second, wantOk: true},
+		{name: "negative seconds", value: "-3", wantOk: false},
+		{name: "http date", value: "Mon, 01 Jul 2024 12:00:05 GMT", wantDelay: 5 * time.Second, wantOk: true},
+		{name: "http date in the past", value: "Mon, 01 Jul 2024 11:00:00 GMT", wantDelay: 0, wantOk: true},
+		{name: "garbage", value: "soon", wantOk: false},
+	}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			delay, ok := ParseRetryAfter(tt.value, now)
+			require.Equal(t, tt.wantOk, ok)
+			require.Equal(t, tt.wantDelay, delay)
+		})
+	}
+}
+
+func TestBackoffIsBounded(t *testing.T) {
+	t.Parallel()
+
+	transport := &RetryTransport{
+		BaseDelay: 10 * time.Millisecond,
+		MaxDelay:  50 * time.Millisecond,
+	}
+
+	for attempt := 0; attempt < 100; attempt++ {
+		delay := transport.backoff(attempt)
+		require.LessOrEqual(t, delay, 50*time.Millisecond)
+		require.GreaterOrEqual(t, delay, 5*time.Millisecond)
+	}
+}
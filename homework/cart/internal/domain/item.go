@@ -1,13 +1,42 @@
 package domain
 
+const (
+	AvailabilityAvailable    = "available"
+	AvailabilityInsufficient = "insufficient"
+	// AvailabilityUnknown marks an item whose stock could not be fetched from loms
+	AvailabilityUnknown = "unknown"
+)
+
 type ListItem struct {
 	SKU   int64
 	Count uint16
 	Name  string
 	Price uint32
+
+	// AvailableCount is the stock count in loms at the moment of listing
+	AvailableCount int
+	// Availability is AvailabilityInsufficient when the stock can not cover the item count,
+	// AvailabilityUnknown when the stock request failed
+	Availability string
 }
 
 type Item struct {
 	SKU   int64
 	Count uint16
 }
+
+// CartItemsNotFoundError is returned by the storages when the user has no items
+type CartItemsNotFoundError struct{}
+
+func (_ CartItemsNotFoundError) Error() string {
+	return "CartItems not found"
+}
+
+// NewAvailability returns the availability flag of the item count against the stock count.
+func NewAvailability(count uint16, availableCount int) string {
+	if availableCount < int(count) {
+		return AvailabilityInsufficient
+	}
+
+	return AvailabilityAvailable
+}
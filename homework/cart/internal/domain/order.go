@@ -5,3 +5,40 @@ type Order struct {
 	UserID int64
 	Items  []Item
 }
+
+type OrderItem struct {
+	SKU   int64
+	Count uint16
+	Name  string
+	Price uint32
+}
+
+// OrderSummary describes the order created by the cart checkout
+type OrderSummary struct {
+	OrderID    int
+	Status     string
+	Items      []OrderItem
+	TotalPrice int
+}
+
+const (
+	PreviewFailureProductNotFound    = "product_not_found"
+	PreviewFailureInsufficientStocks = "insufficient_stocks"
+	PreviewFailureStockNotFound      = "stock_not_found"
+	PreviewFailureStockUnavailable   = "stock_unavailable"
+)
+
+// PreviewFailure describes the cart line which would fail the checkout
+type PreviewFailure struct {
+	SKU            int64
+	Count          uint16
+	AvailableCount int
+	Reason         string
+}
+
+// CheckoutPreview is the dry run result of the cart checkout, a line is either in Items or in Failures
+type CheckoutPreview struct {
+	Items      []OrderItem
+	TotalPrice int
+	Failures   []PreviewFailure
+}
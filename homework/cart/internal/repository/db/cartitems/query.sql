@@ -0,0 +1,27 @@
+-- name: AddCartItem :exec
+INSERT INTO cart_items(user_id, sku, count)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, sku) DO UPDATE
+SET count = cart_items.count + EXCLUDED.count;
+
+-- name: DeleteCartItem :exec
+DELETE FROM cart_items
+WHERE user_id = $1 AND sku = $2;
+
+-- name: DeleteCartItems :exec
+DELETE FROM cart_items
+WHERE user_id = $1;
+
+-- name: GetCartItemCount :one
+SELECT count FROM cart_items
+WHERE user_id = $1 AND sku = $2;
+
+-- name: GetCartItems :many
+SELECT * FROM cart_items
+WHERE user_id = $1;
+
+-- name: SetCartItem :exec
+INSERT INTO cart_items(user_id, sku, count)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, sku) DO UPDATE
+SET count = EXCLUDED.count;
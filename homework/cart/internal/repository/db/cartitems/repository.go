@@ -0,0 +1,186 @@
+package cartitems
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel"
+
+	"route256/cart/internal/domain"
+	"route256/cart/internal/repository/db/transaction"
+	"route256/cart/pkg/prometheus"
+)
+
+type Storage struct {
+	pool *pgxpool.Pool
+	cmd  *Queries
+}
+
+func NewStorage(pool *pgxpool.Pool) *Storage {
+	return &Storage{
+		pool: pool,
+		cmd:  New(pool),
+	}
+}
+
+func (s *Storage) Add(ctx context.Context, userID int64, item domain.Item) error {
+	ctx, span := otel.Tracer("cart").Start(ctx, "db_cart_items_add")
+	defer span.End()
+
+	prometheus.IncDBRequestsTotalCounter("insert")
+
+	startTime := time.Now()
+	err := s.queries(ctx).AddCartItem(ctx, AddCartItemParams{
+		UserID: userID,
+		Sku:    item.SKU,
+		Count:  int32(item.Count),
+	})
+
+	if err != nil {
+		prometheus.ObserveDBRequestsDurationHistogram(startTime, "insert", "error")
+		return err
+	}
+
+	prometheus.ObserveDBRequestsDurationHistogram(startTime, "insert", "success")
+
+	return nil
+}
+
+func (s *Storage) Set(ctx context.Context, userID int64, item domain.Item) error {
+	ctx, span := otel.Tracer("cart").Start(ctx, "db_cart_items_set")
+	defer span.End()
+
+	prometheus.IncDBRequestsTotalCounter("upsert")
+
+	startTime := time.Now()
+	err := s.queries(ctx).SetCartItem(ctx, SetCartItemParams{
+		UserID: userID,
+		Sku:    item.SKU,
+		Count:  int32(item.Count),
+	})
+
+	if err != nil {
+		prometheus.ObserveDBRequestsDurationHistogram(startTime, "upsert", "error")
+		return err
+	}
+
+	prometheus.ObserveDBRequestsDurationHistogram(startTime, "upsert", "success")
+
+	return nil
+}
+
+func (s *Storage) DeleteOne(ctx context.Context, userID, skuID int64) error {
+	ctx, span := otel.Tracer("cart").Start(ctx, "db_cart_items_delete_one")
+	defer span.End()
+
+	prometheus.IncDBRequestsTotalCounter("delete")
+
+	startTime := time.Now()
+	err := s.queries(ctx).DeleteCartItem(ctx, DeleteCartItemParams{
+		UserID: userID,
+		Sku:    skuID,
+	})
+
+	if err != nil {
+		prometheus.ObserveDBRequestsDurationHistogram(startTime, "delete", "error")
+		return err
+	}
+
+	prometheus.ObserveDBRequestsDurationHistogram(startTime, "delete", "success")
+
+	return nil
+}
+
+func (s *Storage) DeleteAll(ctx context.Context, userID int64) error {
+	ctx, span := otel.Tracer("cart").Start(ctx, "db_cart_items_delete_all")
+	defer span.End()
+
+	prometheus.IncDBRequestsTotalCounter("delete")
+
+	startTime := time.Now()
+	err := s.queries(ctx).DeleteCartItems(ctx, userID)
+
+	if err != nil {
+		prometheus.ObserveDBRequestsDurationHistogram(startTime, "delete", "error")
+		return err
+	}
+
+	prometheus.ObserveDBRequestsDurationHistogram(startTime, "delete", "success")
+
+	return nil
+}
+
+func (s *Storage) GetAll(ctx context.Context, userID int64) ([]domain.Item, error) {
+	ctx, span := otel.Tracer("cart").Start(ctx, "db_cart_items_get_all")
+	defer span.End()
+
+	prometheus.IncDBRequestsTotalCounter("select")
+
+	startTime := time.Now()
+	cartItems, err := s.queries(ctx).GetCartItems(ctx, userID)
+
+	if err != nil {
+		prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "error")
+		return nil, err
+	}
+
+	prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "success")
+
+	if len(cartItems) == 0 {
+		return nil, domain.CartItemsNotFoundError{}
+	}
+
+	return repackItems(cartItems), nil
+}
+
+// GetCount returns the count of the sku in the user cart, zero if the cart does not contain the sku.
+func (s *Storage) GetCount(ctx context.Context, userID, skuID int64) (uint16, error) {
+	ctx, span := otel.Tracer("cart").Start(ctx, "db_cart_items_get_count")
+	defer span.End()
+
+	prometheus.IncDBRequestsTotalCounter("select")
+
+	startTime := time.Now()
+	count, err := s.queries(ctx).GetCartItemCount(ctx, GetCartItemCountParams{
+		UserID: userID,
+		Sku:    skuID,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "success")
+			return 0, nil
+		}
+
+		prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "error")
+		return 0, err
+	}
+
+	prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "success")
+
+	return uint16(count), nil
+}
+
+// queries returns the queries bound to the transaction carried by ctx, if any, so the call joins it.
+func (s *Storage) queries(ctx context.Context) *Queries {
+	if tx, ok := transaction.FromContext(ctx); ok {
+		return s.cmd.WithTx(tx)
+	}
+
+	return s.cmd
+}
+
+func repackItems(cartItems []CartItem) []domain.Item {
+	items := make([]domain.Item, len(cartItems))
+	for i, cartItem := range cartItems {
+		items[i] = domain.Item{
+			SKU:   cartItem.Sku,
+			Count: uint16(cartItem.Count),
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,17 @@
+-- name: AddSavedItem :exec
+INSERT INTO saved_items(user_id, sku, count)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, sku) DO UPDATE
+SET count = saved_items.count + EXCLUDED.count;
+
+-- name: DeleteSavedItem :exec
+DELETE FROM saved_items
+WHERE user_id = $1 AND sku = $2;
+
+-- name: GetSavedItemCount :one
+SELECT count FROM saved_items
+WHERE user_id = $1 AND sku = $2;
+
+-- name: GetSavedItems :many
+SELECT * FROM saved_items
+WHERE user_id = $1;
@@ -0,0 +1,53 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type (
+	Manager struct {
+		pool *pgxpool.Pool
+	}
+
+	txKey struct{}
+)
+
+func NewManager(pool *pgxpool.Pool) *Manager {
+	return &Manager{
+		pool: pool,
+	}
+}
+
+// RunInTx runs fn in a single transaction, the repositories called with the ctx passed to fn
+// join it instead of querying the pool. The transaction is committed if fn returns nil.
+func (m *Manager) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := m.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("could not begin transaction: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	err = fn(context.WithValue(ctx, txKey{}, tx))
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// FromContext returns the transaction started by RunInTx, if ctx carries one.
+func FromContext(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
+
+	return tx, ok
+}
@@ -0,0 +1,55 @@
+package idempotencyrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"route256/cart/internal/domain"
+)
+
+type (
+	entry struct {
+		summary   domain.OrderSummary
+		expiresAt time.Time
+	}
+
+	// MemoryStorage remembers summaries of orders created by idempotency keys for ttl,
+	// the least recently used keys are evicted once size is reached.
+	MemoryStorage struct {
+		lru *lru.Cache[string, entry]
+		ttl time.Duration
+		now func() time.Time
+	}
+)
+
+func NewMemoryStorage(size int, ttl time.Duration) (*MemoryStorage, error) {
+	cache, err := lru.New[string, entry](size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create idempotency storage: %w", err)
+	}
+
+	return &MemoryStorage{
+		lru: cache,
+		ttl: ttl,
+		now: time.Now,
+	}, nil
+}
+
+func (s *MemoryStorage) Get(_ context.Context, key string) (domain.OrderSummary, bool) {
+	cached, ok := s.lru.Get(key)
+	if !ok || !s.now().Before(cached.expiresAt) {
+		return domain.OrderSummary{}, false
+	}
+
+	return cached.summary, true
+}
+
+func (s *MemoryStorage) Set(_ context.Context, key string, summary domain.OrderSummary) {
+	s.lru.Add(key, entry{
+		summary:   summary,
+		expiresAt: s.now().Add(s.ttl),
+	})
+}
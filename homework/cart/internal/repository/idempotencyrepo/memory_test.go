@@ -0,0 +1,57 @@
+package idempotencyrepo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"route256/cart/internal/domain"
+)
+
+func TestMemoryStorage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	storage, err := NewMemoryStorage(2, time.Minute)
+	require.NoError(t, err)
+
+	now := time.Now()
+	storage.now = func() time.Time { return now }
+
+	_, ok := storage.Get(ctx, "1:key")
+	require.False(t, ok)
+
+	storage.Set(ctx, "1:key", domain.OrderSummary{OrderID: 10, TotalPrice: 300})
+
+	summary, ok := storage.Get(ctx, "1:key")
+	require.True(t, ok)
+	require.Equal(t, domain.OrderSummary{OrderID: 10, TotalPrice: 300}, summary)
+
+	now = now.Add(2 * time.Minute)
+
+	_, ok = storage.Get(ctx, "1:key")
+	require.False(t, ok)
+}
+
+func TestMemoryStorageEvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	storage, err := NewMemoryStorage(2, time.Minute)
+	require.NoError(t, err)
+
+	storage.Set(ctx, "1:a", domain.OrderSummary{OrderID: 1})
+	storage.Set(ctx, "1:b", domain.OrderSummary{OrderID: 2})
+	storage.Set(ctx, "1:c", domain.OrderSummary{OrderID: 3})
+
+	_, ok := storage.Get(ctx, "1:a")
+	require.False(t, ok)
+
+	summary, ok := storage.Get(ctx, "1:c")
+	require.True(t, ok)
+	require.Equal(t, 3, summary.OrderID)
+}
@@ -3,10 +3,12 @@ package memorycartrepo
 import (
 	"context"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel"
 
 	"route256/cart/internal/domain"
+	"route256/cart/pkg/logger"
 	"route256/cart/pkg/prometheus"
 )
 
@@ -14,22 +16,35 @@ type (
 	itemsMap map[int64]domain.Item
 
 	MemoryStorage struct {
-		items map[int64]itemsMap
-		mtx   sync.RWMutex
+		items       map[int64]itemsMap
+		touchedAt   map[int64]time.Time
+		ttl         time.Duration
+		now         func() time.Time
+		itemsGauge  func(itemsCount int)
+		expiredInc  func(cartsCount int)
+		done        chan struct{}
+		doneOnce    sync.Once
+		snapshotMtx sync.Mutex
+		mtx         sync.RWMutex
 	}
-
-	CartItemsNotFoundError struct{}
 )
 
-func (_ CartItemsNotFoundError) Error() string {
-	return "CartItems not found"
-}
+func NewMemoryStorage(opts ...Option) *MemoryStorage {
+	m := &MemoryStorage{
+		items:      make(map[int64]itemsMap),
+		touchedAt:  make(map[int64]time.Time),
+		now:        time.Now,
+		itemsGauge: prometheus.UpdateMemoryCartItemsTotalCounter,
+		expiredInc: prometheus.AddMemoryCartsExpiredTotalCounter,
+		done:       make(chan struct{}),
+		mtx:        sync.RWMutex{},
+	}
 
-func NewMemoryStorage() *MemoryStorage {
-	return &MemoryStorage{
-		items: make(map[int64]itemsMap),
-		mtx:   sync.RWMutex{},
+	for _, opt := range opts {
+		opt.Apply(m)
 	}
+
+	return m
 }
 
 func (m *MemoryStorage) getTotalCountItems() int {
@@ -44,7 +59,7 @@ func (m *MemoryStorage) getTotalCountItems() int {
 	return count
 }
 
-func (m *MemoryStorage) Add(ctx context.Context, userID int64, item domain.Item) {
+func (m *MemoryStorage) Add(ctx context.Context, userID int64, item domain.Item) error {
 	_, span := otel.Tracer("cart").Start(ctx, "memory_add")
 	defer span.End()
 
@@ -57,39 +72,69 @@ func (m *MemoryStorage) Add(ctx context.Context, userID int64, item domain.Item)
 		SKU:   item.SKU,
 		Count: m.items[userID][item.SKU].Count + item.Count,
 	}
+	m.touchedAt[userID] = m.now()
+	m.mtx.Unlock()
+
+	m.itemsGauge(m.getTotalCountItems())
+
+	return nil
+}
+
+// Set replaces the count of the sku in the user cart instead of adding to it.
+func (m *MemoryStorage) Set(ctx context.Context, userID int64, item domain.Item) error {
+	_, span := otel.Tracer("cart").Start(ctx, "memory_set")
+	defer span.End()
+
+	m.mtx.Lock()
+	if m.items[userID] == nil {
+		m.items[userID] = itemsMap{}
+	}
+
+	m.items[userID][item.SKU] = domain.Item{
+		SKU:   item.SKU,
+		Count: item.Count,
+	}
+	m.touchedAt[userID] = m.now()
 	m.mtx.Unlock()
 
-	prometheus.UpdateMemoryCartItemsTotalCounter(m.getTotalCountItems())
+	m.itemsGauge(m.getTotalCountItems())
+
+	return nil
 }
 
-func (m *MemoryStorage) DeleteOne(ctx context.Context, userID, skuID int64) {
+func (m *MemoryStorage) DeleteOne(ctx context.Context, userID, skuID int64) error {
 	_, span := otel.Tracer("cart").Start(ctx, "memory_delete_one")
 	defer span.End()
 
-	m.mtx.RLock()
+	// Проверка и обновление под одной блокировкой: иначе sweeper может удалить корзину между ними
+	m.mtx.Lock()
 	userItemsMap, ok := m.items[userID]
-	m.mtx.RUnlock()
-
 	if !ok {
-		return
+		m.mtx.Unlock()
+		return nil
 	}
 
-	m.mtx.Lock()
 	delete(userItemsMap, skuID)
+	m.touchedAt[userID] = m.now()
 	m.mtx.Unlock()
 
-	prometheus.UpdateMemoryCartItemsTotalCounter(m.getTotalCountItems())
+	m.itemsGauge(m.getTotalCountItems())
+
+	return nil
 }
 
-func (m *MemoryStorage) DeleteAll(ctx context.Context, userID int64) {
+func (m *MemoryStorage) DeleteAll(ctx context.Context, userID int64) error {
 	_, span := otel.Tracer("cart").Start(ctx, "memory_delete_all")
 	defer span.End()
 
 	m.mtx.Lock()
 	delete(m.items, userID)
+	delete(m.touchedAt, userID)
 	m.mtx.Unlock()
 
-	prometheus.UpdateMemoryCartItemsTotalCounter(m.getTotalCountItems())
+	m.itemsGauge(m.getTotalCountItems())
+
+	return nil
 }
 
 func (m *MemoryStorage) GetAll(ctx context.Context, userID int64) ([]domain.Item, error) {
@@ -101,7 +146,7 @@ func (m *MemoryStorage) GetAll(ctx context.Context, userID int64) ([]domain.Item
 
 	if currentSkuItems, ok := m.items[userID]; ok {
 		if len(currentSkuItems) == 0 {
-			return nil, CartItemsNotFoundError{}
+			return nil, domain.CartItemsNotFoundError{}
 		}
 
 		skuItems := make([]domain.Item, len(currentSkuItems))
@@ -116,7 +161,77 @@ func (m *MemoryStorage) GetAll(ctx context.Context, userID int64) ([]domain.Item
 		return skuItems, nil
 	}
 
-	return nil, CartItemsNotFoundError{}
+	return nil, domain.CartItemsNotFoundError{}
+}
+
+// GetCount returns the count of the sku in the user cart, zero if the cart does not contain the sku.
+func (m *MemoryStorage) GetCount(ctx context.Context, userID, skuID int64) (uint16, error) {
+	_, span := otel.Tracer("cart").Start(ctx, "memory_get_count")
+	defer span.End()
+
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return m.items[userID][skuID].Count, nil
+}
+
+// RunExpirySweeper periodically evicts carts that have not been changed for longer than the storage ttl.
+// It blocks until Stop is called.
+func (m *MemoryStorage) RunExpirySweeper(interval time.Duration) {
+	if m.ttl <= 0 || interval <= 0 {
+		<-m.done
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	ctx := context.Background()
+
+	for {
+		select {
+		case <-m.done:
+			logger.Infow(ctx, "MemoryStorage expiry sweeper shutdown complete")
+			return
+		case <-ticker.C:
+			m.evictExpired(ctx)
+		}
+	}
+}
+
+// Stop stops the background workers of the storage, the expiry sweeper and the snapshotter.
+func (m *MemoryStorage) Stop(_ context.Context) error {
+	m.doneOnce.Do(func() {
+		close(m.done)
+	})
+
+	return nil
+}
+
+func (m *MemoryStorage) evictExpired(ctx context.Context) {
+	_, span := otel.Tracer("cart").Start(ctx, "memory_evict_expired")
+	defer span.End()
+
+	expiredBefore := m.now().Add(-m.ttl)
+	expired := 0
+
+	m.mtx.Lock()
+	for userID, touchedAt := range m.touchedAt {
+		if touchedAt.Before(expiredBefore) {
+			delete(m.items, userID)
+			delete(m.touchedAt, userID)
+
+			expired++
+		}
+	}
+	m.mtx.Unlock()
+
+	if expired == 0 {
+		return
+	}
+
+	m.expiredInc(expired)
+	m.itemsGauge(m.getTotalCountItems())
 }
 
 func (m *MemoryStorage) GetAllOld(_ context.Context, userID int64) ([]domain.Item, error) {
@@ -127,7 +242,7 @@ func (m *MemoryStorage) GetAllOld(_ context.Context, userID int64) ([]domain.Ite
 
 	if currentSkuItems, ok := m.items[userID]; ok {
 		if len(currentSkuItems) == 0 {
-			return nil, CartItemsNotFoundError{}
+			return nil, domain.CartItemsNotFoundError{}
 		}
 
 		for _, currentSkuItem := range currentSkuItems {
@@ -137,5 +252,5 @@ func (m *MemoryStorage) GetAllOld(_ context.Context, userID int64) ([]domain.Ite
 		return skuItems, nil
 	}
 
-	return nil, CartItemsNotFoundError{}
+	return nil, domain.CartItemsNotFoundError{}
 }
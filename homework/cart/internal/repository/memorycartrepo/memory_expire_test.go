@@ -0,0 +1,134 @@
+package memorycartrepo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"route256/cart/internal/domain"
+)
+
+func TestEvictExpiredTable(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type data struct {
+		name       string
+		ttl        time.Duration
+		sinceTouch time.Duration
+		wantExists bool
+	}
+
+	testData := []data{{
+		name:       "Cart has not expired yet",
+		ttl:        time.Hour,
+		sinceTouch: 30 * time.Minute,
+		wantExists: true,
+	}, {
+		name:       "Cart has expired",
+		ttl:        time.Hour,
+		sinceTouch: 2 * time.Hour,
+		wantExists: false,
+	}}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				userID = int64(123)
+				now    = time.Now()
+			)
+
+			storage := NewMemoryStorage(WithTTL(tt.ttl))
+			storage.now = func() time.Time { return now }
+
+			storage.Add(ctx, userID, domain.Item{SKU: 111, Count: 1})
+
+			storage.now = func() time.Time { return now.Add(tt.sinceTouch) }
+			storage.evictExpired(ctx)
+
+			_, err := storage.GetAll(ctx, userID)
+			if tt.wantExists {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, domain.CartItemsNotFoundError{})
+				require.NotContains(t, storage.touchedAt, userID)
+			}
+		})
+	}
+}
+
+func TestDeleteOneProlongsCartLifetime(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		userID  = int64(123)
+		now     = time.Now()
+		storage = NewMemoryStorage(WithTTL(time.Hour))
+	)
+
+	storage.now = func() time.Time { return now }
+	storage.Add(ctx, userID, domain.Item{SKU: 111, Count: 1})
+	storage.Add(ctx, userID, domain.Item{SKU: 222, Count: 1})
+
+	storage.now = func() time.Time { return now.Add(50 * time.Minute) }
+	storage.DeleteOne(ctx, userID, 111)
+
+	storage.now = func() time.Time { return now.Add(90 * time.Minute) }
+	storage.evictExpired(ctx)
+
+	userItems, err := storage.GetAll(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, []domain.Item{{SKU: 222, Count: 1}}, userItems)
+}
+
+func TestDeleteOneAfterEvictionLeavesNoCart(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		userID  = int64(123)
+		now     = time.Now()
+		storage = NewMemoryStorage(WithTTL(time.Hour))
+	)
+
+	storage.now = func() time.Time { return now }
+	storage.Add(ctx, userID, domain.Item{SKU: 111, Count: 1})
+
+	storage.now = func() time.Time { return now.Add(90 * time.Minute) }
+	storage.evictExpired(ctx)
+
+	require.NoError(t, storage.DeleteOne(ctx, userID, 111))
+	require.NotContains(t, storage.items, userID)
+	require.NotContains(t, storage.touchedAt, userID)
+}
+
+func TestExpirySweeperStops(t *testing.T) {
+	t.Parallel()
+
+	storage := NewMemoryStorage(WithTTL(time.Millisecond))
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+		storage.RunExpirySweeper(time.Millisecond)
+	}()
+
+	storage.Add(context.Background(), 123, domain.Item{SKU: 111, Count: 1})
+
+	require.Eventually(t, func() bool {
+		_, err := storage.GetAll(context.Background(), 123)
+		return err != nil
+	}, time.Second, time.Millisecond)
+
+	require.NoError(t, storage.Stop(context.Background()))
+	require.NoError(t, storage.Stop(context.Background()))
+
+	<-stopped
+}
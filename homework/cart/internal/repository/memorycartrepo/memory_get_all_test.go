@@ -3,6 +3,7 @@ package memorycartrepo
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -26,12 +27,12 @@ func TestGetAllTable(t *testing.T) {
 		name:       "User does not have a cart",
 		userID:     123,
 		existItems: nil,
-		wantErr:    CartItemsNotFoundError{},
+		wantErr:    domain.CartItemsNotFoundError{},
 	}, {
 		name:       "User has an empty cart",
 		userID:     938,
 		existItems: itemsMap{},
-		wantErr:    CartItemsNotFoundError{},
+		wantErr:    domain.CartItemsNotFoundError{},
 	}, {
 		name:   "User has cart items",
 		userID: 532,
@@ -45,7 +46,7 @@ func TestGetAllTable(t *testing.T) {
 				Count: 8,
 			},
 		},
-		wantErr: CartItemsNotFoundError{},
+		wantErr: domain.CartItemsNotFoundError{},
 	}}
 
 	for _, tt := range testData {
@@ -205,3 +206,53 @@ func BenchmarkGetAll(b *testing.B) {
 		}
 	})
 }
+
+type benchmarkStorage interface {
+	Add(ctx context.Context, userID int64, item domain.Item) error
+	GetAll(ctx context.Context, userID int64) ([]domain.Item, error)
+}
+
+// BenchmarkGetAllParallel simulates many users working with their carts at the same time:
+// every goroutine adds an item to a cart and reads it back.
+func BenchmarkGetAllParallel(b *testing.B) {
+	const countOfUsers = 1024
+
+	storages := []struct {
+		name    string
+		storage func() benchmarkStorage
+	}{{
+		name:    "MemoryStorage",
+		storage: func() benchmarkStorage { return NewMemoryStorage() },
+	}, {
+		name:    "ShardedMemoryStorage",
+		storage: func() benchmarkStorage { return NewShardedMemoryStorage(DefaultShardsCount) },
+	}}
+
+	for _, s := range storages {
+		b.Run(s.name, func(b *testing.B) {
+			ctx := context.Background()
+			storage := s.storage()
+
+			for userID := range countOfUsers {
+				storage.Add(ctx, int64(userID), domain.Item{SKU: 195, Count: 1})
+			}
+
+			var goroutineID atomic.Int64
+
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				userID := goroutineID.Add(1)
+
+				for pb.Next() {
+					userID = (userID + 1) % countOfUsers
+					storage.Add(ctx, userID, domain.Item{SKU: 865, Count: 1})
+
+					if _, err := storage.GetAll(ctx, userID); err != nil {
+						b.Errorf("GetAll failed: %v", err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package memorycartrepo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"route256/cart/internal/domain"
+)
+
+func TestGetCountTable(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type data struct {
+		name        string
+		userID      int64
+		skuID       int64
+		existItem   *domain.Item
+		expectCount uint16
+	}
+
+	testData := []data{{
+		name:        "User does not have a cart",
+		userID:      123,
+		skuID:       111,
+		existItem:   nil,
+		expectCount: 0,
+	}, {
+		name:   "User does not have an item with the same SKU",
+		userID: 456,
+		skuID:  111,
+		existItem: &domain.Item{
+			SKU:   195,
+			Count: 4,
+		},
+		expectCount: 0,
+	}, {
+		name:   "User has an item with the same SKU",
+		userID: 938,
+		skuID:  195,
+		existItem: &domain.Item{
+			SKU:   195,
+			Count: 4,
+		},
+		expectCount: 4,
+	}}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := NewMemoryStorage()
+
+			if tt.existItem != nil {
+				storage.items[tt.userID] = itemsMap{}
+				storage.items[tt.userID][tt.existItem.SKU] = *tt.existItem
+			}
+
+			count, err := storage.GetCount(ctx, tt.userID, tt.skuID)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectCount, count)
+		})
+	}
+}
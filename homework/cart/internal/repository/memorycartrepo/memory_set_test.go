@@ -0,0 +1,71 @@
+package memorycartrepo
+
+import (
+	"context"
+	"testing"
+
+	"route256/cart/internal/domain"
+)
+
+func TestSetTable(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type data struct {
+		name        string
+		userID      int64
+		item        *domain.Item
+		existItem   *domain.Item
+		expectCount uint16
+	}
+
+	testData := []data{{
+		name:      "User does not have an item with the same SKU",
+		userID:    123,
+		existItem: nil,
+		item: &domain.Item{
+			SKU:   111,
+			Count: 5,
+		},
+		expectCount: 5,
+	}, {
+		name:   "User has an item with the same SKU",
+		userID: 938,
+		existItem: &domain.Item{
+			SKU:   195,
+			Count: 5,
+		},
+		item: &domain.Item{
+			SKU:   195,
+			Count: 2,
+		},
+		expectCount: 2,
+	}}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := NewMemoryStorage()
+
+			if tt.existItem != nil {
+				storage.items[tt.userID] = itemsMap{}
+				storage.items[tt.userID][tt.existItem.SKU] = *tt.existItem
+			}
+
+			storage.Set(ctx, tt.userID, *tt.item)
+			userItems := storage.items[tt.userID]
+
+			setItem, ok := userItems[tt.item.SKU]
+			if !ok {
+				t.Errorf("Item with SKU %d was not set in the storage", tt.item.SKU)
+			}
+
+			if setItem.Count != tt.expectCount {
+				t.Errorf("Incorrect item count. Expected: %d, Got: %d", tt.expectCount, setItem.Count)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package memorycartrepo
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+
+	"route256/cart/internal/domain"
+	"route256/cart/pkg/prometheus"
+)
+
+const DefaultShardsCount = 32
+
+type (
+	shard struct {
+		items map[int64]itemsMap
+		mtx   sync.RWMutex
+	}
+
+	// ShardedMemoryStorage splits carts into buckets by userID, each bucket guarded by its own lock,
+	// so requests of different users do not contend on a single mutex.
+	ShardedMemoryStorage struct {
+		shards     []*shard
+		totalItems atomic.Int64
+	}
+)
+
+func NewShardedMemoryStorage(shardsCount int) *ShardedMemoryStorage {
+	if shardsCount <= 0 {
+		shardsCount = DefaultShardsCount
+	}
+
+	shards := make([]*shard, shardsCount)
+	for i := range shards {
+		shards[i] = &shard{
+			items: make(map[int64]itemsMap),
+		}
+	}
+
+	return &ShardedMemoryStorage{
+		shards: shards,
+	}
+}
+
+func (m *ShardedMemoryStorage) getShard(userID int64) *shard {
+	return m.shards[uint64(userID)%uint64(len(m.shards))]
+}
+
+func (m *ShardedMemoryStorage) Add(ctx context.Context, userID int64, item domain.Item) error {
+	_, span := otel.Tracer("cart").Start(ctx, "sharded_memory_add")
+	defer span.End()
+
+	s := m.getShard(userID)
+
+	s.mtx.Lock()
+	if s.items[userID] == nil {
+		s.items[userID] = itemsMap{}
+	}
+
+	existItem, ok := s.items[userID][item.SKU]
+	s.items[userID][item.SKU] = domain.Item{
+		SKU:   item.SKU,
+		Count: existItem.Count + item.Count,
+	}
+	s.mtx.Unlock()
+
+	if !ok {
+		m.totalItems.Add(1)
+		prometheus.AddMemoryCartItemsTotalCounter(1)
+	}
+
+	return nil
+}
+
+func (m *ShardedMemoryStorage) Set(ctx context.Context, userID int64, item domain.Item) error {
+	_, span := otel.Tracer("cart").Start(ctx, "sharded_memory_set")
+	defer span.End()
+
+	s := m.getShard(userID)
+
+	s.mtx.Lock()
+	if s.items[userID] == nil {
+		s.items[userID] = itemsMap{}
+	}
+
+	_, ok := s.items[userID][item.SKU]
+	s.items[userID][item.SKU] = domain.Item{
+		SKU:   item.SKU,
+		Count: item.Count,
+	}
+	s.mtx.Unlock()
+
+	if !ok {
+		m.totalItems.Add(1)
+		prometheus.AddMemoryCartItemsTotalCounter(1)
+	}
+
+	return nil
+}
+
+func (m *ShardedMemoryStorage) DeleteOne(ctx context.Context, userID, skuID int64) error {
+	_, span := otel.Tracer("cart").Start(ctx, "sharded_memory_delete_one")
+	defer span.End()
+
+	s := m.getShard(userID)
+
+	s.mtx.Lock()
+	_, ok := s.items[userID][skuID]
+	if ok {
+		delete(s.items[userID], skuID)
+	}
+	s.mtx.Unlock()
+
+	if ok {
+		m.totalItems.Add(-1)
+		prometheus.AddMemoryCartItemsTotalCounter(-1)
+	}
+
+	return nil
+}
+
+func (m *ShardedMemoryStorage) DeleteAll(ctx context.Context, userID int64) error {
+	_, span := otel.Tracer("cart").Start(ctx, "sharded_memory_delete_all")
+	defer span.End()
+
+	s := m.getShard(userID)
+
+	s.mtx.Lock()
+	deleted := len(s.items[userID])
+	delete(s.items, userID)
+	s.mtx.Unlock()
+
+	if deleted > 0 {
+		m.totalItems.Add(-int64(deleted))
+		prometheus.AddMemoryCartItemsTotalCounter(-deleted)
+	}
+
+	return nil
+}
+
+func (m *ShardedMemoryStorage) GetAll(ctx context.Context, userID int64) ([]domain.Item, error) {
+	_, span := otel.Tracer("cart").Start(ctx, "sharded_memory_get_all")
+	defer span.End()
+
+	s := m.getShard(userID)
+
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	currentSkuItems := s.items[userID]
+	if len(currentSkuItems) == 0 {
+		return nil, domain.CartItemsNotFoundError{}
+	}
+
+	skuItems := make([]domain.Item, 0, len(currentSkuItems))
+	for _, currentSkuItem := range currentSkuItems {
+		skuItems = append(skuItems, currentSkuItem)
+	}
+
+	return skuItems, nil
+}
+
+func (m *ShardedMemoryStorage) GetCount(ctx context.Context, userID, skuID int64) (uint16, error) {
+	_, span := otel.Tracer("cart").Start(ctx, "sharded_memory_get_count")
+	defer span.End()
+
+	s := m.getShard(userID)
+
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return s.items[userID][skuID].Count, nil
+}
+
+// TotalCountItems returns the number of distinct cart positions of all users.
+func (m *ShardedMemoryStorage) TotalCountItems() int {
+	return int(m.totalItems.Load())
+}
@@ -0,0 +1,129 @@
+package memorycartrepo
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"route256/cart/internal/domain"
+)
+
+func TestShardedAddTable(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type data struct {
+		name        string
+		userID      int64
+		item        domain.Item
+		existItem   *domain.Item
+		expectCount uint16
+		expectTotal int
+	}
+
+	testData := []data{{
+		name:   "User does not have an item with the same SKU",
+		userID: 123,
+		item: domain.Item{
+			SKU:   111,
+			Count: 5,
+		},
+		expectCount: 5,
+		expectTotal: 1,
+	}, {
+		name:   "User has an item with the same SKU",
+		userID: 938,
+		existItem: &domain.Item{
+			SKU:   195,
+			Count: 4,
+		},
+		item: domain.Item{
+			SKU:   195,
+			Count: 5,
+		},
+		expectCount: 9,
+		expectTotal: 1,
+	}}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := NewShardedMemoryStorage(4)
+
+			if tt.existItem != nil {
+				storage.Add(ctx, tt.userID, *tt.existItem)
+			}
+
+			storage.Add(ctx, tt.userID, tt.item)
+
+			userItems, err := storage.GetAll(ctx, tt.userID)
+			require.NoError(t, err)
+			require.Equal(t, []domain.Item{{SKU: tt.item.SKU, Count: tt.expectCount}}, userItems)
+			require.Equal(t, tt.expectTotal, storage.TotalCountItems())
+		})
+	}
+}
+
+func TestShardedDeleteKeepsTotalCount(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		storage = NewShardedMemoryStorage(4)
+	)
+
+	storage.Add(ctx, 1, domain.Item{SKU: 111, Count: 1})
+	storage.Add(ctx, 1, domain.Item{SKU: 222, Count: 1})
+	storage.Add(ctx, 2, domain.Item{SKU: 111, Count: 1})
+	require.Equal(t, 3, storage.TotalCountItems())
+
+	storage.DeleteOne(ctx, 1, 111)
+	storage.DeleteOne(ctx, 1, 333)
+	storage.DeleteOne(ctx, 3, 111)
+	require.Equal(t, 2, storage.TotalCountItems())
+
+	storage.DeleteAll(ctx, 2)
+	storage.DeleteAll(ctx, 3)
+	require.Equal(t, 1, storage.TotalCountItems())
+
+	_, err := storage.GetAll(ctx, 2)
+	require.ErrorIs(t, err, domain.CartItemsNotFoundError{})
+}
+
+func TestShardedAddForDifferentUsersConcurrently(t *testing.T) {
+	t.Parallel()
+
+	var (
+		countOfUsers    = 100
+		countOfProducts = 10
+		wg              = sync.WaitGroup{}
+		ctx             = context.Background()
+		storage         = NewShardedMemoryStorage(8)
+	)
+
+	wg.Add(countOfUsers * countOfProducts)
+
+	for userID := range countOfUsers {
+		for sku := range countOfProducts {
+			go func() {
+				defer wg.Done()
+				storage.Add(ctx, int64(userID), domain.Item{SKU: int64(sku), Count: 1})
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	require.Equal(t, countOfUsers*countOfProducts, storage.TotalCountItems())
+
+	for userID := range countOfUsers {
+		userItems, err := storage.GetAll(ctx, int64(userID))
+		require.NoError(t, err)
+		require.Len(t, userItems, countOfProducts)
+	}
+}
@@ -0,0 +1,35 @@
+package memorycartrepo
+
+import (
+	"time"
+
+	"route256/cart/pkg/prometheus"
+)
+
+// Option is a configuration callback.
+type Option interface {
+	Apply(*MemoryStorage)
+}
+
+type optionFn func(*MemoryStorage)
+
+func (fn optionFn) Apply(m *MemoryStorage) {
+	fn(m)
+}
+
+// WithTTL sets the time after the last change when a cart is considered abandoned.
+// A zero ttl disables expiration.
+func WithTTL(ttl time.Duration) Option {
+	return optionFn(func(m *MemoryStorage) {
+		m.ttl = ttl
+	})
+}
+
+// WithSavedItemsMetrics reports the storage items and expirations to the saved for later metrics
+// instead of the cart ones, so a saved for later storage does not overwrite the cart items gauge.
+func WithSavedItemsMetrics() Option {
+	return optionFn(func(m *MemoryStorage) {
+		m.itemsGauge = prometheus.UpdateMemorySavedItemsTotalCounter
+		m.expiredInc = prometheus.AddMemorySavedListsExpiredTotalCounter
+	})
+}
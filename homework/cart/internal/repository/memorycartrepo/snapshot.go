@@ -0,0 +1,206 @@
+package memorycartrepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"route256/cart/internal/domain"
+	"route256/cart/pkg/logger"
+)
+
+const snapshotVersion = 1
+
+var ErrSnapshotVersion = errors.New("unsupported snapshot version")
+
+type (
+	snapshotHeader struct {
+		Version   int       `json:"version"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	snapshotCart struct {
+		UserID    int64          `json:"user_id"`
+		TouchedAt time.Time      `json:"touched_at"`
+		Items     []snapshotItem `json:"items"`
+	}
+
+	snapshotItem struct {
+		SKU   int64  `json:"sku"`
+		Count uint16 `json:"count"`
+	}
+)
+
+// SaveSnapshot writes all carts to the file at path. The data is written to a temporary file
+// in the same directory first and then renamed, so a crash never leaves a partially written snapshot.
+func (m *MemoryStorage) SaveSnapshot(path string) (err error) {
+	// Сохранения выполняются по очереди, чтобы более старый снимок не заменил более новый
+	m.snapshotMtx.Lock()
+	defer m.snapshotMtx.Unlock()
+
+	carts := m.snapshotCarts()
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary snapshot file: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	encoder := json.NewEncoder(tmp)
+
+	if err = encoder.Encode(snapshotHeader{Version: snapshotVersion, CreatedAt: m.now()}); err != nil {
+		return fmt.Errorf("failed to encode snapshot header: %w", err)
+	}
+
+	if err = encoder.Encode(carts); err != nil {
+		return fmt.Errorf("failed to encode snapshot carts: %w", err)
+	}
+
+	if err = tmp.Sync(); err != nil {
+		return fmt.Errorf("failed to sync snapshot file: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close snapshot file: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace snapshot file: %w", err)
+	}
+
+	// Без sync каталога переименование может потеряться при падении, и останется старый снимок
+	if err = syncDir(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("failed to sync snapshot directory: %w", err)
+	}
+
+	return nil
+}
+
+func syncDir(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	if err = dir.Sync(); err != nil {
+		_ = dir.Close()
+		return err
+	}
+
+	return dir.Close()
+}
+
+// LoadSnapshot replaces the storage content with carts from the file at path.
+// If the file does not exist, the returned error wraps os.ErrNotExist.
+func (m *MemoryStorage) LoadSnapshot(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open snapshot file: %w", err)
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	decoder := json.NewDecoder(file)
+
+	var header snapshotHeader
+	if err = decoder.Decode(&header); err != nil {
+		return fmt.Errorf("failed to decode snapshot header: %w", err)
+	}
+
+	if header.Version != snapshotVersion {
+		return fmt.Errorf("%w: %d", ErrSnapshotVersion, header.Version)
+	}
+
+	var carts []snapshotCart
+	if err = decoder.Decode(&carts); err != nil {
+		return fmt.Errorf("failed to decode snapshot carts: %w", err)
+	}
+
+	items := make(map[int64]itemsMap, len(carts))
+	touchedAt := make(map[int64]time.Time, len(carts))
+
+	for _, cart := range carts {
+		userItems := make(itemsMap, len(cart.Items))
+		for _, item := range cart.Items {
+			userItems[item.SKU] = domain.Item{
+				SKU:   item.SKU,
+				Count: item.Count,
+			}
+		}
+
+		items[cart.UserID] = userItems
+		touchedAt[cart.UserID] = cart.TouchedAt
+	}
+
+	m.mtx.Lock()
+	m.items = items
+	m.touchedAt = touchedAt
+	m.mtx.Unlock()
+
+	m.itemsGauge(m.getTotalCountItems())
+
+	return nil
+}
+
+// RunSnapshotter periodically saves the storage to the file at path.
+// It blocks until Stop is called.
+func (m *MemoryStorage) RunSnapshotter(path string, interval time.Duration) {
+	if interval <= 0 {
+		<-m.done
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	ctx := context.Background()
+
+	for {
+		select {
+		case <-m.done:
+			logger.Infow(ctx, "MemoryStorage snapshotter shutdown complete")
+			return
+		case <-ticker.C:
+			if err := m.SaveSnapshot(path); err != nil {
+				logger.Errorw(ctx, "failed to save cart snapshot", "error", err, "path", path)
+			}
+		}
+	}
+}
+
+func (m *MemoryStorage) snapshotCarts() []snapshotCart {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	carts := make([]snapshotCart, 0, len(m.items))
+
+	for userID, userItems := range m.items {
+		items := make([]snapshotItem, 0, len(userItems))
+		for _, item := range userItems {
+			items = append(items, snapshotItem{
+				SKU:   item.SKU,
+				Count: item.Count,
+			})
+		}
+
+		carts = append(carts, snapshotCart{
+			UserID:    userID,
+			TouchedAt: m.touchedAt[userID],
+			Items:     items,
+		})
+	}
+
+	return carts
+}
@@ -0,0 +1,83 @@
+package memorycartrepo
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"route256/cart/internal/domain"
+)
+
+func TestSnapshotSaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx     = context.Background()
+		path    = filepath.Join(t.TempDir(), "carts.snapshot")
+		now     = time.Now().UTC().Truncate(time.Second)
+		storage = NewMemoryStorage()
+	)
+
+	storage.now = func() time.Time { return now }
+	storage.Add(ctx, 123, domain.Item{SKU: 111, Count: 5})
+	storage.Add(ctx, 123, domain.Item{SKU: 222, Count: 1})
+	storage.Add(ctx, 938, domain.Item{SKU: 111, Count: 2})
+
+	require.NoError(t, storage.SaveSnapshot(path))
+
+	restored := NewMemoryStorage()
+	require.NoError(t, restored.LoadSnapshot(path))
+
+	require.Equal(t, storage.items, restored.items)
+	require.True(t, restored.touchedAt[123].Equal(now))
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "temporary snapshot files must not be left")
+}
+
+func TestSnapshotLoadErrors(t *testing.T) {
+	t.Parallel()
+
+	type data struct {
+		name    string
+		content *string
+		wantErr error
+	}
+
+	unsupported := `{"version":100,"created_at":"2024-01-01T00:00:00Z"}` + "\n[]\n"
+
+	testData := []data{{
+		name:    "Snapshot file does not exist",
+		content: nil,
+		wantErr: os.ErrNotExist,
+	}, {
+		name:    "Snapshot has unsupported version",
+		content: &unsupported,
+		wantErr: ErrSnapshotVersion,
+	}}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "carts.snapshot")
+
+			if tt.content != nil {
+				require.NoError(t, os.WriteFile(path, []byte(*tt.content), 0o600))
+			}
+
+			storage := NewMemoryStorage()
+			storage.Add(context.Background(), 123, domain.Item{SKU: 111, Count: 5})
+
+			err := storage.LoadSnapshot(path)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Len(t, storage.items[123], 1, "storage must not be changed by a failed restore")
+		})
+	}
+}
@@ -4,57 +4,183 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"time"
 
 	"go.opentelemetry.io/otel"
+	"golang.org/x/sync/singleflight"
 
 	"route256/cart/internal/clients/loms"
+	"route256/cart/internal/clients/product"
 	"route256/cart/internal/domain"
-	"route256/cart/internal/repository/memorycartrepo"
+	"route256/cart/pkg/logger"
 )
 
+// sharedCheckoutTimeout bounds the checkout shared by the concurrent replays, it outlives the request that started it
+const sharedCheckoutTimeout = 10 * time.Second
+
 type (
 	lomsService interface {
-		CreateOrder(ctx context.Context, userID int64, items []domain.Item) (int, error)
+		CreateOrder(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) (int, error)
+		InfoOrder(ctx context.Context, orderID int) (*domain.Order, error)
+	}
+
+	productService interface {
+		GetProductsInfo(ctx context.Context, skus []uint32) (map[uint32]*domain.Product, error)
 	}
 
 	repository interface {
 		GetAll(ctx context.Context, userID int64) ([]domain.Item, error)
-		DeleteAll(_ context.Context, userID int64)
+		DeleteAll(_ context.Context, userID int64) error
+	}
+
+	idempotencyStorage interface {
+		Get(ctx context.Context, key string) (domain.OrderSummary, bool)
+		Set(ctx context.Context, key string, summary domain.OrderSummary)
 	}
 
 	Handler struct {
-		repo        repository
-		lomsService lomsService
+		repo           repository
+		productService productService
+		lomsService    lomsService
+		idempotency    idempotencyStorage
+		group          singleflight.Group
 	}
 )
 
-func New(repo repository, lomsService lomsService) *Handler {
+func New(repo repository, productService productService, lomsService lomsService, idempotency idempotencyStorage) *Handler {
 	return &Handler{
-		repo:        repo,
-		lomsService: lomsService,
+		repo:           repo,
+		productService: productService,
+		lomsService:    lomsService,
+		idempotency:    idempotency,
 	}
 }
 
-func (h *Handler) CartCheckout(ctx context.Context, userID int64) (*int, error) {
+// CartCheckout creates an order from the user cart, clears the cart and returns the order summary.
+// A repeated checkout with the same non-empty idempotencyKey returns the summary of the already created order.
+func (h *Handler) CartCheckout(ctx context.Context, userID int64, idempotencyKey string) (*domain.OrderSummary, error) {
 	ctx, span := otel.Tracer("cart").Start(ctx, "service_cart_checkout")
 	defer span.End()
 
+	if idempotencyKey == "" {
+		return h.checkout(ctx, userID, idempotencyKey)
+	}
+
+	// Ключи разных пользователей не пересекаются
+	key := strconv.FormatInt(userID, 10) + ":" + idempotencyKey
+
+	if summary, ok := h.idempotency.Get(ctx, key); ok {
+		return &summary, nil
+	}
+
+	// Одновременные повторы с тем же ключом ждут первый запрос, а не создают второй заказ
+	resultCh := h.group.DoChan(key, func() (interface{}, error) {
+		// Отмена запроса, запустившего checkout, не должна обрывать его для остальных ожидающих повторов
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sharedCheckoutTimeout)
+		defer cancel()
+
+		if summary, ok := h.idempotency.Get(ctx, key); ok {
+			return &summary, nil
+		}
+
+		summary, err := h.checkout(ctx, userID, idempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		h.idempotency.Set(ctx, key, *summary)
+
+		return summary, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-resultCh:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+
+		summary := *result.Val.(*domain.OrderSummary)
+
+		return &summary, nil
+	}
+}
+
+func (h *Handler) checkout(ctx context.Context, userID int64, idempotencyKey string) (*domain.OrderSummary, error) {
 	cartItems, err := h.repo.GetAll(ctx, userID)
 
 	if err != nil {
-		if errors.Is(err, memorycartrepo.CartItemsNotFoundError{}) {
-			return nil, memorycartrepo.CartItemsNotFoundError{}
+		if errors.Is(err, domain.CartItemsNotFoundError{}) {
+			return nil, domain.CartItemsNotFoundError{}
 		}
 
 		return nil, fmt.Errorf("repository.GetCart: %w", err)
 	}
 
-	orderID, err := h.lomsService.CreateOrder(ctx, userID, cartItems)
+	sort.Slice(cartItems, func(i, j int) bool {
+		return cartItems[i].SKU < cartItems[j].SKU
+	})
+
+	// Сводку собираем до создания заказа, пока корзина ещё не очищена
+	summary, err := h.newSummary(ctx, cartItems)
+	if err != nil {
+		return nil, err
+	}
+
+	summary.OrderID, err = h.lomsService.CreateOrder(ctx, userID, cartItems, idempotencyKey)
 	if err != nil {
 		return nil, fmt.Errorf("%w %w", loms.ErrCreateOrder, err)
 	}
 
-	h.repo.DeleteAll(ctx, userID)
+	// Заказ уже создан, поэтому без статуса сводка всё равно возвращается
+	order, err := h.lomsService.InfoOrder(ctx, summary.OrderID)
+	if err != nil {
+		logger.Errorw(ctx, "failed to get created order status", "order_id", summary.OrderID, "error", err)
+	} else {
+		summary.Status = order.Status
+	}
+
+	// Заказ уже создан, неочищенная корзина не отменяет его, поэтому ошибку только логируем
+	if err = h.repo.DeleteAll(ctx, userID); err != nil {
+		logger.Errorw(ctx, "failed to clear cart after checkout", "order_id", summary.OrderID, "error", err)
+	}
+
+	return summary, nil
+}
+
+func (h *Handler) newSummary(ctx context.Context, cartItems []domain.Item) (*domain.OrderSummary, error) {
+	skus := make([]uint32, len(cartItems))
+	for i, item := range cartItems {
+		skus[i] = uint32(item.SKU)
+	}
+
+	products, err := h.productService.GetProductsInfo(ctx, skus)
+	if err != nil {
+		return nil, fmt.Errorf("%w %w", product.ErrGetProductInfo, err)
+	}
+
+	summary := &domain.OrderSummary{
+		Items: make([]domain.OrderItem, len(cartItems)),
+	}
+
+	for i, item := range cartItems {
+		productInfo, ok := products[uint32(item.SKU)]
+		if !ok || productInfo == nil {
+			return nil, fmt.Errorf("%w %w: SKU=%d", product.ErrGetProductInfo, product.ErrProductNotFound, item.SKU)
+		}
+
+		summary.Items[i] = domain.OrderItem{
+			SKU:   item.SKU,
+			Count: item.Count,
+			Name:  productInfo.Name,
+			Price: productInfo.Price,
+		}
+
+		summary.TotalPrice += int(productInfo.Price) * int(item.Count)
+	}
 
-	return &orderID, nil
+	return summary, nil
 }
@@ -3,15 +3,19 @@ package checkout
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
 
 	"route256/cart/internal/clients/loms"
+	"route256/cart/internal/clients/product"
 	"route256/cart/internal/domain"
-	"route256/cart/internal/repository/memorycartrepo"
+	"route256/cart/internal/repository/idempotencyrepo"
 	"route256/cart/internal/service/cart/checkout/mock"
 )
 
@@ -19,6 +23,51 @@ func TestMain(m *testing.M) {
 	goleak.VerifyTestMain(m)
 }
 
+func getCartItems() []domain.Item {
+	return []domain.Item{
+		{
+			SKU:   983,
+			Count: 2,
+		},
+		{
+			SKU:   125,
+			Count: 1,
+		},
+	}
+}
+
+func getProducts() map[uint32]*domain.Product {
+	return map[uint32]*domain.Product{
+		125: {Name: "Ручка", Price: 50},
+		983: {Name: "Книга", Price: 300},
+	}
+}
+
+func getSortedCartItems() []domain.Item {
+	return []domain.Item{
+		{
+			SKU:   125,
+			Count: 1,
+		},
+		{
+			SKU:   983,
+			Count: 2,
+		},
+	}
+}
+
+func getSummary(orderID int, status string) domain.OrderSummary {
+	return domain.OrderSummary{
+		OrderID: orderID,
+		Status:  status,
+		Items: []domain.OrderItem{
+			{SKU: 125, Count: 1, Name: "Ручка", Price: 50},
+			{SKU: 983, Count: 2, Name: "Книга", Price: 300},
+		},
+		TotalPrice: 650,
+	}
+}
+
 func TestCheckoutCartWithRepoErrorsWithPrepare(t *testing.T) {
 	t.Parallel()
 
@@ -26,8 +75,9 @@ func TestCheckoutCartWithRepoErrorsWithPrepare(t *testing.T) {
 
 	type (
 		fields struct {
-			repMock  *mock.RepositoryMock
-			lomsMock *mock.LomsServiceMock
+			repMock     *mock.RepositoryMock
+			productMock *mock.ProductServiceMock
+			lomsMock    *mock.LomsServiceMock
 		}
 
 		data struct {
@@ -42,9 +92,9 @@ func TestCheckoutCartWithRepoErrorsWithPrepare(t *testing.T) {
 		name:   "Repo returns CartItemsNotFoundError",
 		userID: 123,
 		prepare: func(f *fields) {
-			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(nil, memorycartrepo.CartItemsNotFoundError{})
+			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(nil, domain.CartItemsNotFoundError{})
 		},
-		wantErr: memorycartrepo.CartItemsNotFoundError{},
+		wantErr: domain.CartItemsNotFoundError{},
 	}, {
 		name:   "Repo returns an error different from CartItemsNotFoundError",
 		userID: 123,
@@ -61,14 +111,15 @@ func TestCheckoutCartWithRepoErrorsWithPrepare(t *testing.T) {
 
 			ctrl := minimock.NewController(t)
 			fieldsForTableTest := fields{
-				repMock:  mock.NewRepositoryMock(ctrl),
-				lomsMock: mock.NewLomsServiceMock(ctrl),
+				repMock:     mock.NewRepositoryMock(ctrl),
+				productMock: mock.NewProductServiceMock(ctrl),
+				lomsMock:    mock.NewLomsServiceMock(ctrl),
 			}
 
-			checkoutHandler := New(fieldsForTableTest.repMock, fieldsForTableTest.lomsMock)
+			checkoutHandler := New(fieldsForTableTest.repMock, fieldsForTableTest.productMock, fieldsForTableTest.lomsMock, mock.NewIdempotencyStorageMock(ctrl))
 
 			tt.prepare(&fieldsForTableTest)
-			_, err := checkoutHandler.CartCheckout(ctx, tt.userID)
+			_, err := checkoutHandler.CartCheckout(ctx, tt.userID, "")
 			require.EqualError(t, err, tt.wantErr.Error())
 		})
 	}
@@ -81,49 +132,145 @@ func TestCheckoutCartWithPrepare(t *testing.T) {
 
 	type (
 		fields struct {
-			repMock  *mock.RepositoryMock
-			lomsMock *mock.LomsServiceMock
+			repMock     *mock.RepositoryMock
+			productMock *mock.ProductServiceMock
+			lomsMock    *mock.LomsServiceMock
 		}
 
 		data struct {
-			name    string
-			userID  int64
-			prepare func(f *fields)
-			wantErr error
+			name        string
+			userID      int64
+			prepare     func(f *fields)
+			wantSummary *domain.OrderSummary
+			wantErr     error
 		}
 	)
 
 	testData := []data{{
+		name:   "product service returned error",
+		userID: 123,
+		prepare: func(f *fields) {
+			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(getCartItems(), nil)
+			f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{125, 983}).Return(nil, fmt.Errorf("test error"))
+		},
+		wantErr: product.ErrGetProductInfo,
+	}, {
+		name:   "product is missing",
+		userID: 123,
+		prepare: func(f *fields) {
+			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(getCartItems(), nil)
+			f.productMock.GetProductsInfoMock.Return(map[uint32]*domain.Product{983: {Name: "Книга", Price: 300}}, nil)
+		},
+		wantErr: product.ErrProductNotFound,
+	}, {
 		name:   "loms service returned error",
 		userID: 123,
 		prepare: func(f *fields) {
-			cartItems := []domain.Item{
-				{
-					SKU:   983,
-					Count: 2,
-				},
-			}
-			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(cartItems, nil)
-			f.lomsMock.CreateOrderMock.ExpectUserIDParam2(123).ExpectItemsParam3(cartItems).Return(0, fmt.Errorf("test error"))
+			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(getCartItems(), nil)
+			f.productMock.GetProductsInfoMock.Return(getProducts(), nil)
+			f.lomsMock.CreateOrderMock.ExpectUserIDParam2(123).ExpectItemsParam3(getSortedCartItems()).ExpectIdempotencyKeyParam4("").Return(0, fmt.Errorf("test error"))
 		},
 		wantErr: loms.ErrCreateOrder,
 	}, {
 		name:   "Success",
 		userID: 123,
 		prepare: func(f *fields) {
-			cartItems := []domain.Item{
-				{
-					SKU:   983,
-					Count: 2,
-				},
+			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(getCartItems(), nil)
+			f.productMock.GetProductsInfoMock.Return(getProducts(), nil)
+			f.lomsMock.CreateOrderMock.ExpectUserIDParam2(123).ExpectItemsParam3(getSortedCartItems()).ExpectIdempotencyKeyParam4("").Return(2, nil)
+			f.lomsMock.InfoOrderMock.ExpectOrderIDParam2(2).Return(&domain.Order{Status: "awaiting payment"}, nil)
+			f.repMock.DeleteAllMock.Times(1).ExpectUserIDParam2(123).Return(nil)
+		},
+		wantSummary: func() *domain.OrderSummary {
+			summary := getSummary(2, "awaiting payment")
+			return &summary
+		}(),
+	}, {
+		name:   "Success without order status",
+		userID: 123,
+		prepare: func(f *fields) {
+			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(getCartItems(), nil)
+			f.productMock.GetProductsInfoMock.Return(getProducts(), nil)
+			f.lomsMock.CreateOrderMock.Return(2, nil)
+			f.lomsMock.InfoOrderMock.Return(nil, fmt.Errorf("test error"))
+			f.repMock.DeleteAllMock.Times(1).ExpectUserIDParam2(123).Return(nil)
+		},
+		wantSummary: func() *domain.OrderSummary {
+			summary := getSummary(2, "")
+			return &summary
+		}(),
+	}}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			fieldsForTableTest := fields{
+				repMock:     mock.NewRepositoryMock(ctrl),
+				productMock: mock.NewProductServiceMock(ctrl),
+				lomsMock:    mock.NewLomsServiceMock(ctrl),
 			}
-			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(cartItems, nil)
-			f.lomsMock.CreateOrderMock.ExpectUserIDParam2(123).ExpectItemsParam3(cartItems).Return(2, nil)
-			f.repMock.DeleteAllMock.ExpectUserIDParam2(123).Return()
 
-			f.repMock.DeleteAllMock.Times(1)
+			checkoutHandler := New(fieldsForTableTest.repMock, fieldsForTableTest.productMock, fieldsForTableTest.lomsMock, mock.NewIdempotencyStorageMock(ctrl))
+
+			tt.prepare(&fieldsForTableTest)
+			summary, err := checkoutHandler.CartCheckout(ctx, tt.userID, "")
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.wantSummary, summary)
+		})
+	}
+}
+
+func TestCheckoutCartWithIdempotencyKeyWithPrepare(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type (
+		fields struct {
+			repMock         *mock.RepositoryMock
+			productMock     *mock.ProductServiceMock
+			lomsMock        *mock.LomsServiceMock
+			idempotencyMock *mock.IdempotencyStorageMock
+		}
+
+		data struct {
+			name        string
+			prepare     func(f *fields)
+			wantOrderID int
+			wantErr     error
+		}
+	)
+
+	testData := []data{{
+		name: "replay returns remembered order",
+		prepare: func(f *fields) {
+			f.idempotencyMock.GetMock.ExpectKeyParam2("123:key").Return(getSummary(7, "awaiting payment"), true)
 		},
-		wantErr: nil,
+		wantOrderID: 7,
+	}, {
+		name: "first request creates order and remembers it",
+		prepare: func(f *fields) {
+			f.idempotencyMock.GetMock.Times(2).ExpectKeyParam2("123:key").Return(domain.OrderSummary{}, false)
+			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(getCartItems(), nil)
+			f.productMock.GetProductsInfoMock.Return(getProducts(), nil)
+			f.lomsMock.CreateOrderMock.ExpectUserIDParam2(123).ExpectItemsParam3(getSortedCartItems()).ExpectIdempotencyKeyParam4("key").Return(2, nil)
+			f.lomsMock.InfoOrderMock.Return(&domain.Order{Status: "awaiting payment"}, nil)
+			f.repMock.DeleteAllMock.Times(1).ExpectUserIDParam2(123).Return(nil)
+			f.idempotencyMock.SetMock.Times(1).ExpectKeyParam2("123:key").ExpectSummaryParam3(getSummary(2, "awaiting payment")).Return()
+		},
+		wantOrderID: 2,
+	}, {
+		name: "failed order is not remembered",
+		prepare: func(f *fields) {
+			f.idempotencyMock.GetMock.Times(2).ExpectKeyParam2("123:key").Return(domain.OrderSummary{}, false)
+			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(getCartItems(), nil)
+			f.productMock.GetProductsInfoMock.Return(getProducts(), nil)
+			f.lomsMock.CreateOrderMock.ExpectUserIDParam2(123).ExpectItemsParam3(getSortedCartItems()).ExpectIdempotencyKeyParam4("key").Return(0, fmt.Errorf("test error"))
+		},
+		wantErr: loms.ErrCreateOrder,
 	}}
 
 	for _, tt := range testData {
@@ -133,15 +280,143 @@ func TestCheckoutCartWithPrepare(t *testing.T) {
 
 			ctrl := minimock.NewController(t)
 			fieldsForTableTest := fields{
-				repMock:  mock.NewRepositoryMock(ctrl),
-				lomsMock: mock.NewLomsServiceMock(ctrl),
+				repMock:         mock.NewRepositoryMock(ctrl),
+				productMock:     mock.NewProductServiceMock(ctrl),
+				lomsMock:        mock.NewLomsServiceMock(ctrl),
+				idempotencyMock: mock.NewIdempotencyStorageMock(ctrl),
 			}
 
-			checkoutHandler := New(fieldsForTableTest.repMock, fieldsForTableTest.lomsMock)
+			checkoutHandler := New(fieldsForTableTest.repMock, fieldsForTableTest.productMock, fieldsForTableTest.lomsMock, fieldsForTableTest.idempotencyMock)
 
 			tt.prepare(&fieldsForTableTest)
-			_, err := checkoutHandler.CartCheckout(ctx, tt.userID)
+			summary, err := checkoutHandler.CartCheckout(ctx, 123, "key")
 			require.ErrorIs(t, err, tt.wantErr)
+
+			if tt.wantErr == nil {
+				require.Equal(t, tt.wantOrderID, summary.OrderID)
+			}
 		})
 	}
 }
+
+func TestCheckoutCartConcurrentReplaysCreateSingleOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := minimock.NewController(t)
+	repMock := mock.NewRepositoryMock(ctrl)
+	productMock := mock.NewProductServiceMock(ctrl)
+	lomsMock := mock.NewLomsServiceMock(ctrl)
+
+	idempotency, err := idempotencyrepo.NewMemoryStorage(10, time.Minute)
+	require.NoError(t, err)
+
+	var orders atomic.Int64
+
+	repMock.GetAllMock.Return(getCartItems(), nil)
+	repMock.DeleteAllMock.Return(nil)
+	productMock.GetProductsInfoMock.Return(getProducts(), nil)
+	lomsMock.CreateOrderMock.Set(func(_ context.Context, _ int64, _ []domain.Item, _ string) (int, error) {
+		time.Sleep(10 * time.Millisecond)
+
+		return int(orders.Add(1)), nil
+	})
+	lomsMock.InfoOrderMock.Return(&domain.Order{Status: "awaiting payment"}, nil)
+
+	checkoutHandler := New(repMock, productMock, lomsMock, idempotency)
+
+	const requests = 5
+
+	orderIDs := make([]int, requests)
+	errs := make([]error, requests)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			summary, err := checkoutHandler.CartCheckout(ctx, 123, "key")
+			if err == nil {
+				orderIDs[i] = summary.OrderID
+			}
+
+			errs[i] = err
+		}()
+	}
+
+	wg.Wait()
+
+	for i := 0; i < requests; i++ {
+		require.NoError(t, errs[i])
+		require.Equal(t, 1, orderIDs[i])
+	}
+
+	require.Equal(t, int64(1), orders.Load())
+}
+
+func TestCheckoutCartCancelledFirstRequestDoesNotFailReplays(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	repMock := mock.NewRepositoryMock(ctrl)
+	productMock := mock.NewProductServiceMock(ctrl)
+	lomsMock := mock.NewLomsServiceMock(ctrl)
+
+	idempotency, err := idempotencyrepo.NewMemoryStorage(10, time.Minute)
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var createCtx context.Context
+
+	repMock.GetAllMock.Return(getCartItems(), nil)
+	repMock.DeleteAllMock.Return(nil)
+	productMock.GetProductsInfoMock.Return(getProducts(), nil)
+	lomsMock.CreateOrderMock.Set(func(ctx context.Context, _ int64, _ []domain.Item, _ string) (int, error) {
+		createCtx = ctx
+		close(started)
+		<-release
+
+		return 1, ctx.Err()
+	})
+	lomsMock.InfoOrderMock.Return(&domain.Order{Status: "awaiting payment"}, nil)
+
+	checkoutHandler := New(repMock, productMock, lomsMock, idempotency)
+
+	firstCtx, cancel := context.WithCancel(context.Background())
+
+	firstErr := make(chan error)
+	go func() {
+		_, err := checkoutHandler.CartCheckout(firstCtx, 123, "key")
+		firstErr <- err
+	}()
+
+	<-started
+	cancel()
+
+	// Первый запрос отменён, но общий checkout продолжает работу
+	require.ErrorIs(t, <-firstErr, context.Canceled)
+	require.NoError(t, createCtx.Err())
+
+	var (
+		summary   *domain.OrderSummary
+		replayErr error
+	)
+
+	replayDone := make(chan struct{})
+	go func() {
+		defer close(replayDone)
+
+		summary, replayErr = checkoutHandler.CartCheckout(context.Background(), 123, "key")
+	}()
+
+	close(release)
+	<-replayDone
+
+	require.NoError(t, replayErr)
+	require.Equal(t, 1, summary.OrderID)
+}
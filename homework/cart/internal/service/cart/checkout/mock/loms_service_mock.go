@@ -19,11 +19,17 @@ type LomsServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcCreateOrder          func(ctx context.Context, userID int64, items []domain.Item) (i1 int, err error)
-	inspectFuncCreateOrder   func(ctx context.Context, userID int64, items []domain.Item)
+	funcCreateOrder          func(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) (i1 int, err error)
+	inspectFuncCreateOrder   func(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string)
 	afterCreateOrderCounter  uint64
 	beforeCreateOrderCounter uint64
 	CreateOrderMock          mLomsServiceMockCreateOrder
+
+	funcInfoOrder          func(ctx context.Context, orderID int) (op1 *domain.Order, err error)
+	inspectFuncInfoOrder   func(ctx context.Context, orderID int)
+	afterInfoOrderCounter  uint64
+	beforeInfoOrderCounter uint64
+	InfoOrderMock          mLomsServiceMockInfoOrder
 }
 
 // NewLomsServiceMock returns a mock for checkout.lomsService
@@ -37,6 +43,9 @@ func NewLomsServiceMock(t minimock.Tester) *LomsServiceMock {
 	m.CreateOrderMock = mLomsServiceMockCreateOrder{mock: m}
 	m.CreateOrderMock.callArgs = []*LomsServiceMockCreateOrderParams{}
 
+	m.InfoOrderMock = mLomsServiceMockInfoOrder{mock: m}
+	m.InfoOrderMock.callArgs = []*LomsServiceMockInfoOrderParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -65,16 +74,18 @@ type LomsServiceMockCreateOrderExpectation struct {
 
 // LomsServiceMockCreateOrderParams contains parameters of the lomsService.CreateOrder
 type LomsServiceMockCreateOrderParams struct {
-	ctx    context.Context
-	userID int64
-	items  []domain.Item
+	ctx            context.Context
+	userID         int64
+	items          []domain.Item
+	idempotencyKey string
 }
 
 // LomsServiceMockCreateOrderParamPtrs contains pointers to parameters of the lomsService.CreateOrder
 type LomsServiceMockCreateOrderParamPtrs struct {
-	ctx    *context.Context
-	userID *int64
-	items  *[]domain.Item
+	ctx            *context.Context
+	userID         *int64
+	items          *[]domain.Item
+	idempotencyKey *string
 }
 
 // LomsServiceMockCreateOrderResults contains results of the lomsService.CreateOrder
@@ -94,7 +105,7 @@ func (mmCreateOrder *mLomsServiceMockCreateOrder) Optional() *mLomsServiceMockCr
 }
 
 // Expect sets up expected params for lomsService.CreateOrder
-func (mmCreateOrder *mLomsServiceMockCreateOrder) Expect(ctx context.Context, userID int64, items []domain.Item) *mLomsServiceMockCreateOrder {
+func (mmCreateOrder *mLomsServiceMockCreateOrder) Expect(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) *mLomsServiceMockCreateOrder {
 	if mmCreateOrder.mock.funcCreateOrder != nil {
 		mmCreateOrder.mock.t.Fatalf("LomsServiceMock.CreateOrder mock is already set by Set")
 	}
@@ -107,7 +118,7 @@ func (mmCreateOrder *mLomsServiceMockCreateOrder) Expect(ctx context.Context, us
 		mmCreateOrder.mock.t.Fatalf("LomsServiceMock.CreateOrder mock is already set by ExpectParams functions")
 	}
 
-	mmCreateOrder.defaultExpectation.params = &LomsServiceMockCreateOrderParams{ctx, userID, items}
+	mmCreateOrder.defaultExpectation.params = &LomsServiceMockCreateOrderParams{ctx, userID, items, idempotencyKey}
 	for _, e := range mmCreateOrder.expectations {
 		if minimock.Equal(e.params, mmCreateOrder.defaultExpectation.params) {
 			mmCreateOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOrder.defaultExpectation.params)
@@ -183,8 +194,30 @@ func (mmCreateOrder *mLomsServiceMockCreateOrder) ExpectItemsParam3(items []doma
 	return mmCreateOrder
 }
 
+// ExpectIdempotencyKeyParam4 sets up expected param idempotencyKey for lomsService.CreateOrder
+func (mmCreateOrder *mLomsServiceMockCreateOrder) ExpectIdempotencyKeyParam4(idempotencyKey string) *mLomsServiceMockCreateOrder {
+	if mmCreateOrder.mock.funcCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("LomsServiceMock.CreateOrder mock is already set by Set")
+	}
+
+	if mmCreateOrder.defaultExpectation == nil {
+		mmCreateOrder.defaultExpectation = &LomsServiceMockCreateOrderExpectation{}
+	}
+
+	if mmCreateOrder.defaultExpectation.params != nil {
+		mmCreateOrder.mock.t.Fatalf("LomsServiceMock.CreateOrder mock is already set by Expect")
+	}
+
+	if mmCreateOrder.defaultExpectation.paramPtrs == nil {
+		mmCreateOrder.defaultExpectation.paramPtrs = &LomsServiceMockCreateOrderParamPtrs{}
+	}
+	mmCreateOrder.defaultExpectation.paramPtrs.idempotencyKey = &idempotencyKey
+
+	return mmCreateOrder
+}
+
 // Inspect accepts an inspector function that has same arguments as the lomsService.CreateOrder
-func (mmCreateOrder *mLomsServiceMockCreateOrder) Inspect(f func(ctx context.Context, userID int64, items []domain.Item)) *mLomsServiceMockCreateOrder {
+func (mmCreateOrder *mLomsServiceMockCreateOrder) Inspect(f func(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string)) *mLomsServiceMockCreateOrder {
 	if mmCreateOrder.mock.inspectFuncCreateOrder != nil {
 		mmCreateOrder.mock.t.Fatalf("Inspect function is already set for LomsServiceMock.CreateOrder")
 	}
@@ -208,7 +241,7 @@ func (mmCreateOrder *mLomsServiceMockCreateOrder) Return(i1 int, err error) *Lom
 }
 
 // Set uses given function f to mock the lomsService.CreateOrder method
-func (mmCreateOrder *mLomsServiceMockCreateOrder) Set(f func(ctx context.Context, userID int64, items []domain.Item) (i1 int, err error)) *LomsServiceMock {
+func (mmCreateOrder *mLomsServiceMockCreateOrder) Set(f func(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) (i1 int, err error)) *LomsServiceMock {
 	if mmCreateOrder.defaultExpectation != nil {
 		mmCreateOrder.mock.t.Fatalf("Default expectation is already set for the lomsService.CreateOrder method")
 	}
@@ -223,14 +256,14 @@ func (mmCreateOrder *mLomsServiceMockCreateOrder) Set(f func(ctx context.Context
 
 // When sets expectation for the lomsService.CreateOrder which will trigger the result defined by the following
 // Then helper
-func (mmCreateOrder *mLomsServiceMockCreateOrder) When(ctx context.Context, userID int64, items []domain.Item) *LomsServiceMockCreateOrderExpectation {
+func (mmCreateOrder *mLomsServiceMockCreateOrder) When(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) *LomsServiceMockCreateOrderExpectation {
 	if mmCreateOrder.mock.funcCreateOrder != nil {
 		mmCreateOrder.mock.t.Fatalf("LomsServiceMock.CreateOrder mock is already set by Set")
 	}
 
 	expectation := &LomsServiceMockCreateOrderExpectation{
 		mock:   mmCreateOrder.mock,
-		params: &LomsServiceMockCreateOrderParams{ctx, userID, items},
+		params: &LomsServiceMockCreateOrderParams{ctx, userID, items, idempotencyKey},
 	}
 	mmCreateOrder.expectations = append(mmCreateOrder.expectations, expectation)
 	return expectation
@@ -263,15 +296,15 @@ func (mmCreateOrder *mLomsServiceMockCreateOrder) invocationsDone() bool {
 }
 
 // CreateOrder implements checkout.lomsService
-func (mmCreateOrder *LomsServiceMock) CreateOrder(ctx context.Context, userID int64, items []domain.Item) (i1 int, err error) {
+func (mmCreateOrder *LomsServiceMock) CreateOrder(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) (i1 int, err error) {
 	mm_atomic.AddUint64(&mmCreateOrder.beforeCreateOrderCounter, 1)
 	defer mm_atomic.AddUint64(&mmCreateOrder.afterCreateOrderCounter, 1)
 
 	if mmCreateOrder.inspectFuncCreateOrder != nil {
-		mmCreateOrder.inspectFuncCreateOrder(ctx, userID, items)
+		mmCreateOrder.inspectFuncCreateOrder(ctx, userID, items, idempotencyKey)
 	}
 
-	mm_params := LomsServiceMockCreateOrderParams{ctx, userID, items}
+	mm_params := LomsServiceMockCreateOrderParams{ctx, userID, items, idempotencyKey}
 
 	// Record call args
 	mmCreateOrder.CreateOrderMock.mutex.Lock()
@@ -290,7 +323,7 @@ func (mmCreateOrder *LomsServiceMock) CreateOrder(ctx context.Context, userID in
 		mm_want := mmCreateOrder.CreateOrderMock.defaultExpectation.params
 		mm_want_ptrs := mmCreateOrder.CreateOrderMock.defaultExpectation.paramPtrs
 
-		mm_got := LomsServiceMockCreateOrderParams{ctx, userID, items}
+		mm_got := LomsServiceMockCreateOrderParams{ctx, userID, items, idempotencyKey}
 
 		if mm_want_ptrs != nil {
 
@@ -306,6 +339,10 @@ func (mmCreateOrder *LomsServiceMock) CreateOrder(ctx context.Context, userID in
 				mmCreateOrder.t.Errorf("LomsServiceMock.CreateOrder got unexpected parameter items, want: %#v, got: %#v%s\n", *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
 			}
 
+			if mm_want_ptrs.idempotencyKey != nil && !minimock.Equal(*mm_want_ptrs.idempotencyKey, mm_got.idempotencyKey) {
+				mmCreateOrder.t.Errorf("LomsServiceMock.CreateOrder got unexpected parameter idempotencyKey, want: %#v, got: %#v%s\n", *mm_want_ptrs.idempotencyKey, mm_got.idempotencyKey, minimock.Diff(*mm_want_ptrs.idempotencyKey, mm_got.idempotencyKey))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmCreateOrder.t.Errorf("LomsServiceMock.CreateOrder got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -317,9 +354,9 @@ func (mmCreateOrder *LomsServiceMock) CreateOrder(ctx context.Context, userID in
 		return (*mm_results).i1, (*mm_results).err
 	}
 	if mmCreateOrder.funcCreateOrder != nil {
-		return mmCreateOrder.funcCreateOrder(ctx, userID, items)
+		return mmCreateOrder.funcCreateOrder(ctx, userID, items, idempotencyKey)
 	}
-	mmCreateOrder.t.Fatalf("Unexpected call to LomsServiceMock.CreateOrder. %v %v %v", ctx, userID, items)
+	mmCreateOrder.t.Fatalf("Unexpected call to LomsServiceMock.CreateOrder. %v %v %v %v", ctx, userID, items, idempotencyKey)
 	return
 }
 
@@ -391,11 +428,334 @@ func (m *LomsServiceMock) MinimockCreateOrderInspect() {
 	}
 }
 
+type mLomsServiceMockInfoOrder struct {
+	optional           bool
+	mock               *LomsServiceMock
+	defaultExpectation *LomsServiceMockInfoOrderExpectation
+	expectations       []*LomsServiceMockInfoOrderExpectation
+
+	callArgs []*LomsServiceMockInfoOrderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LomsServiceMockInfoOrderExpectation specifies expectation struct of the lomsService.InfoOrder
+type LomsServiceMockInfoOrderExpectation struct {
+	mock      *LomsServiceMock
+	params    *LomsServiceMockInfoOrderParams
+	paramPtrs *LomsServiceMockInfoOrderParamPtrs
+	results   *LomsServiceMockInfoOrderResults
+	Counter   uint64
+}
+
+// LomsServiceMockInfoOrderParams contains parameters of the lomsService.InfoOrder
+type LomsServiceMockInfoOrderParams struct {
+	ctx     context.Context
+	orderID int
+}
+
+// LomsServiceMockInfoOrderParamPtrs contains pointers to parameters of the lomsService.InfoOrder
+type LomsServiceMockInfoOrderParamPtrs struct {
+	ctx     *context.Context
+	orderID *int
+}
+
+// LomsServiceMockInfoOrderResults contains results of the lomsService.InfoOrder
+type LomsServiceMockInfoOrderResults struct {
+	op1 *domain.Order
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option by default unless you really need it, as it helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmInfoOrder *mLomsServiceMockInfoOrder) Optional() *mLomsServiceMockInfoOrder {
+	mmInfoOrder.optional = true
+	return mmInfoOrder
+}
+
+// Expect sets up expected params for lomsService.InfoOrder
+func (mmInfoOrder *mLomsServiceMockInfoOrder) Expect(ctx context.Context, orderID int) *mLomsServiceMockInfoOrder {
+	if mmInfoOrder.mock.funcInfoOrder != nil {
+		mmInfoOrder.mock.t.Fatalf("LomsServiceMock.InfoOrder mock is already set by Set")
+	}
+
+	if mmInfoOrder.defaultExpectation == nil {
+		mmInfoOrder.defaultExpectation = &LomsServiceMockInfoOrderExpectation{}
+	}
+
+	if mmInfoOrder.defaultExpectation.paramPtrs != nil {
+		mmInfoOrder.mock.t.Fatalf("LomsServiceMock.InfoOrder mock is already set by ExpectParams functions")
+	}
+
+	mmInfoOrder.defaultExpectation.params = &LomsServiceMockInfoOrderParams{ctx, orderID}
+	for _, e := range mmInfoOrder.expectations {
+		if minimock.Equal(e.params, mmInfoOrder.defaultExpectation.params) {
+			mmInfoOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmInfoOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmInfoOrder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for lomsService.InfoOrder
+func (mmInfoOrder *mLomsServiceMockInfoOrder) ExpectCtxParam1(ctx context.Context) *mLomsServiceMockInfoOrder {
+	if mmInfoOrder.mock.funcInfoOrder != nil {
+		mmInfoOrder.mock.t.Fatalf("LomsServiceMock.InfoOrder mock is already set by Set")
+	}
+
+	if mmInfoOrder.defaultExpectation == nil {
+		mmInfoOrder.defaultExpectation = &LomsServiceMockInfoOrderExpectation{}
+	}
+
+	if mmInfoOrder.defaultExpectation.params != nil {
+		mmInfoOrder.mock.t.Fatalf("LomsServiceMock.InfoOrder mock is already set by Expect")
+	}
+
+	if mmInfoOrder.defaultExpectation.paramPtrs == nil {
+		mmInfoOrder.defaultExpectation.paramPtrs = &LomsServiceMockInfoOrderParamPtrs{}
+	}
+	mmInfoOrder.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmInfoOrder
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for lomsService.InfoOrder
+func (mmInfoOrder *mLomsServiceMockInfoOrder) ExpectOrderIDParam2(orderID int) *mLomsServiceMockInfoOrder {
+	if mmInfoOrder.mock.funcInfoOrder != nil {
+		mmInfoOrder.mock.t.Fatalf("LomsServiceMock.InfoOrder mock is already set by Set")
+	}
+
+	if mmInfoOrder.defaultExpectation == nil {
+		mmInfoOrder.defaultExpectation = &LomsServiceMockInfoOrderExpectation{}
+	}
+
+	if mmInfoOrder.defaultExpectation.params != nil {
+		mmInfoOrder.mock.t.Fatalf("LomsServiceMock.InfoOrder mock is already set by Expect")
+	}
+
+	if mmInfoOrder.defaultExpectation.paramPtrs == nil {
+		mmInfoOrder.defaultExpectation.paramPtrs = &LomsServiceMockInfoOrderParamPtrs{}
+	}
+	mmInfoOrder.defaultExpectation.paramPtrs.orderID = &orderID
+
+	return mmInfoOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the lomsService.InfoOrder
+func (mmInfoOrder *mLomsServiceMockInfoOrder) Inspect(f func(ctx context.Context, orderID int)) *mLomsServiceMockInfoOrder {
+	if mmInfoOrder.mock.inspectFuncInfoOrder != nil {
+		mmInfoOrder.mock.t.Fatalf("Inspect function is already set for LomsServiceMock.InfoOrder")
+	}
+
+	mmInfoOrder.mock.inspectFuncInfoOrder = f
+
+	return mmInfoOrder
+}
+
+// Return sets up results that will be returned by lomsService.InfoOrder
+func (mmInfoOrder *mLomsServiceMockInfoOrder) Return(op1 *domain.Order, err error) *LomsServiceMock {
+	if mmInfoOrder.mock.funcInfoOrder != nil {
+		mmInfoOrder.mock.t.Fatalf("LomsServiceMock.InfoOrder mock is already set by Set")
+	}
+
+	if mmInfoOrder.defaultExpectation == nil {
+		mmInfoOrder.defaultExpectation = &LomsServiceMockInfoOrderExpectation{mock: mmInfoOrder.mock}
+	}
+	mmInfoOrder.defaultExpectation.results = &LomsServiceMockInfoOrderResults{op1, err}
+	return mmInfoOrder.mock
+}
+
+// Set uses given function f to mock the lomsService.InfoOrder method
+func (mmInfoOrder *mLomsServiceMockInfoOrder) Set(f func(ctx context.Context, orderID int) (op1 *domain.Order, err error)) *LomsServiceMock {
+	if mmInfoOrder.defaultExpectation != nil {
+		mmInfoOrder.mock.t.Fatalf("Default expectation is already set for the lomsService.InfoOrder method")
+	}
+
+	if len(mmInfoOrder.expectations) > 0 {
+		mmInfoOrder.mock.t.Fatalf("Some expectations are already set for the lomsService.InfoOrder method")
+	}
+
+	mmInfoOrder.mock.funcInfoOrder = f
+	return mmInfoOrder.mock
+}
+
+// When sets expectation for the lomsService.InfoOrder which will trigger the result defined by the following
+// Then helper
+func (mmInfoOrder *mLomsServiceMockInfoOrder) When(ctx context.Context, orderID int) *LomsServiceMockInfoOrderExpectation {
+	if mmInfoOrder.mock.funcInfoOrder != nil {
+		mmInfoOrder.mock.t.Fatalf("LomsServiceMock.InfoOrder mock is already set by Set")
+	}
+
+	expectation := &LomsServiceMockInfoOrderExpectation{
+		mock:   mmInfoOrder.mock,
+		params: &LomsServiceMockInfoOrderParams{ctx, orderID},
+	}
+	mmInfoOrder.expectations = append(mmInfoOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up lomsService.InfoOrder return parameters for the expectation previously defined by the When method
+func (e *LomsServiceMockInfoOrderExpectation) Then(op1 *domain.Order, err error) *LomsServiceMock {
+	e.results = &LomsServiceMockInfoOrderResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times lomsService.InfoOrder should be invoked
+func (mmInfoOrder *mLomsServiceMockInfoOrder) Times(n uint64) *mLomsServiceMockInfoOrder {
+	if n == 0 {
+		mmInfoOrder.mock.t.Fatalf("Times of LomsServiceMock.InfoOrder mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmInfoOrder.expectedInvocations, n)
+	return mmInfoOrder
+}
+
+func (mmInfoOrder *mLomsServiceMockInfoOrder) invocationsDone() bool {
+	if len(mmInfoOrder.expectations) == 0 && mmInfoOrder.defaultExpectation == nil && mmInfoOrder.mock.funcInfoOrder == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmInfoOrder.mock.afterInfoOrderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmInfoOrder.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// InfoOrder implements checkout.lomsService
+func (mmInfoOrder *LomsServiceMock) InfoOrder(ctx context.Context, orderID int) (op1 *domain.Order, err error) {
+	mm_atomic.AddUint64(&mmInfoOrder.beforeInfoOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmInfoOrder.afterInfoOrderCounter, 1)
+
+	if mmInfoOrder.inspectFuncInfoOrder != nil {
+		mmInfoOrder.inspectFuncInfoOrder(ctx, orderID)
+	}
+
+	mm_params := LomsServiceMockInfoOrderParams{ctx, orderID}
+
+	// Record call args
+	mmInfoOrder.InfoOrderMock.mutex.Lock()
+	mmInfoOrder.InfoOrderMock.callArgs = append(mmInfoOrder.InfoOrderMock.callArgs, &mm_params)
+	mmInfoOrder.InfoOrderMock.mutex.Unlock()
+
+	for _, e := range mmInfoOrder.InfoOrderMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmInfoOrder.InfoOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmInfoOrder.InfoOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmInfoOrder.InfoOrderMock.defaultExpectation.params
+		mm_want_ptrs := mmInfoOrder.InfoOrderMock.defaultExpectation.paramPtrs
+
+		mm_got := LomsServiceMockInfoOrderParams{ctx, orderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmInfoOrder.t.Errorf("LomsServiceMock.InfoOrder got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmInfoOrder.t.Errorf("LomsServiceMock.InfoOrder got unexpected parameter orderID, want: %#v, got: %#v%s\n", *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmInfoOrder.t.Errorf("LomsServiceMock.InfoOrder got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmInfoOrder.InfoOrderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmInfoOrder.t.Fatal("No results are set for the LomsServiceMock.InfoOrder")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmInfoOrder.funcInfoOrder != nil {
+		return mmInfoOrder.funcInfoOrder(ctx, orderID)
+	}
+	mmInfoOrder.t.Fatalf("Unexpected call to LomsServiceMock.InfoOrder. %v %v", ctx, orderID)
+	return
+}
+
+// InfoOrderAfterCounter returns a count of finished LomsServiceMock.InfoOrder invocations
+func (mmInfoOrder *LomsServiceMock) InfoOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInfoOrder.afterInfoOrderCounter)
+}
+
+// InfoOrderBeforeCounter returns a count of LomsServiceMock.InfoOrder invocations
+func (mmInfoOrder *LomsServiceMock) InfoOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmInfoOrder.beforeInfoOrderCounter)
+}
+
+// Calls returns a list of arguments used in each call to LomsServiceMock.InfoOrder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmInfoOrder *mLomsServiceMockInfoOrder) Calls() []*LomsServiceMockInfoOrderParams {
+	mmInfoOrder.mutex.RLock()
+
+	argCopy := make([]*LomsServiceMockInfoOrderParams, len(mmInfoOrder.callArgs))
+	copy(argCopy, mmInfoOrder.callArgs)
+
+	mmInfoOrder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockInfoOrderDone returns true if the count of the InfoOrder invocations corresponds
+// the number of defined expectations
+func (m *LomsServiceMock) MinimockInfoOrderDone() bool {
+	if m.InfoOrderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.InfoOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.InfoOrderMock.invocationsDone()
+}
+
+// MinimockInfoOrderInspect logs each unmet expectation
+func (m *LomsServiceMock) MinimockInfoOrderInspect() {
+	for _, e := range m.InfoOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LomsServiceMock.InfoOrder with params: %#v", *e.params)
+		}
+	}
+
+	afterInfoOrderCounter := mm_atomic.LoadUint64(&m.afterInfoOrderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.InfoOrderMock.defaultExpectation != nil && afterInfoOrderCounter < 1 {
+		if m.InfoOrderMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LomsServiceMock.InfoOrder")
+		} else {
+			m.t.Errorf("Expected call to LomsServiceMock.InfoOrder with params: %#v", *m.InfoOrderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcInfoOrder != nil && afterInfoOrderCounter < 1 {
+		m.t.Error("Expected call to LomsServiceMock.InfoOrder")
+	}
+
+	if !m.InfoOrderMock.invocationsDone() && afterInfoOrderCounter > 0 {
+		m.t.Errorf("Expected %d calls to LomsServiceMock.InfoOrder but found %d calls",
+			mm_atomic.LoadUint64(&m.InfoOrderMock.expectedInvocations), afterInfoOrderCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *LomsServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockCreateOrderInspect()
+
+			m.MinimockInfoOrderInspect()
 			m.t.FailNow()
 		}
 	})
@@ -420,5 +780,6 @@ func (m *LomsServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *LomsServiceMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockCreateOrderDone()
+		m.MinimockCreateOrderDone() &&
+		m.MinimockInfoOrderDone()
 }
@@ -2,13 +2,15 @@ package delete
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/otel"
 )
 
 type (
 	repository interface {
-		DeleteAll(ctx context.Context, userID int64)
+		DeleteAll(ctx context.Context, userID int64) error
 	}
 
 	Handler struct {
@@ -16,15 +18,21 @@ type (
 	}
 )
 
+var ErrDeleteCartItems = errors.New("failed to delete cart items")
+
 func New(repo repository) *Handler {
 	return &Handler{
 		repo: repo,
 	}
 }
 
-func (h *Handler) DeleteItemsByUserID(ctx context.Context, userID int64) {
+func (h *Handler) DeleteItemsByUserID(ctx context.Context, userID int64) error {
 	ctx, span := otel.Tracer("cart").Start(ctx, "service_delete_items_by_user_id")
 	defer span.End()
 
-	h.repo.DeleteAll(ctx, userID)
+	if err := h.repo.DeleteAll(ctx, userID); err != nil {
+		return fmt.Errorf("%w %w", ErrDeleteCartItems, err)
+	}
+
+	return nil
 }
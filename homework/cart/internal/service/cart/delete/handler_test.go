@@ -2,9 +2,11 @@ package delete
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
 
 	"route256/cart/internal/service/cart/delete/mock"
 )
@@ -28,7 +30,22 @@ func TestDeleteItemsByUserID(t *testing.T) {
 	repMock := mock.NewRepositoryMock(ctrl)
 	deleteHandler := New(repMock)
 
-	repMock.DeleteAllMock.ExpectUserIDParam2(testData.userID).Return()
-	deleteHandler.DeleteItemsByUserID(ctx, testData.userID)
+	repMock.DeleteAllMock.ExpectUserIDParam2(testData.userID).Return(nil)
+	err := deleteHandler.DeleteItemsByUserID(ctx, testData.userID)
+	require.NoError(t, err)
 	repMock.DeleteAllMock.Times(1)
 }
+
+func TestDeleteItemsByUserIDStorageError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := minimock.NewController(t)
+	repMock := mock.NewRepositoryMock(ctrl)
+	deleteHandler := New(repMock)
+
+	repMock.DeleteAllMock.ExpectUserIDParam2(123).Return(fmt.Errorf("test error"))
+	err := deleteHandler.DeleteItemsByUserID(ctx, 123)
+	require.ErrorIs(t, err, ErrDeleteCartItems)
+}
@@ -18,7 +18,7 @@ type RepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcDeleteAll          func(ctx context.Context, userID int64)
+	funcDeleteAll          func(ctx context.Context, userID int64) (err error)
 	inspectFuncDeleteAll   func(ctx context.Context, userID int64)
 	afterDeleteAllCounter  uint64
 	beforeDeleteAllCounter uint64
@@ -58,8 +58,8 @@ type RepositoryMockDeleteAllExpectation struct {
 	mock      *RepositoryMock
 	params    *RepositoryMockDeleteAllParams
 	paramPtrs *RepositoryMockDeleteAllParamPtrs
-
-	Counter uint64
+	results   *RepositoryMockDeleteAllResults
+	Counter   uint64
 }
 
 // RepositoryMockDeleteAllParams contains parameters of the repository.DeleteAll
@@ -74,6 +74,11 @@ type RepositoryMockDeleteAllParamPtrs struct {
 	userID *int64
 }
 
+// RepositoryMockDeleteAllResults contains results of the repository.DeleteAll
+type RepositoryMockDeleteAllResults struct {
+	err error
+}
+
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
 // the test will fail minimock's automatic final call check if the mocked method was not called at least once.
 // Optional() makes method check to work in '0 or more' mode.
@@ -164,7 +169,7 @@ func (mmDeleteAll *mRepositoryMockDeleteAll) Inspect(f func(ctx context.Context,
 }
 
 // Return sets up results that will be returned by repository.DeleteAll
-func (mmDeleteAll *mRepositoryMockDeleteAll) Return() *RepositoryMock {
+func (mmDeleteAll *mRepositoryMockDeleteAll) Return(err error) *RepositoryMock {
 	if mmDeleteAll.mock.funcDeleteAll != nil {
 		mmDeleteAll.mock.t.Fatalf("RepositoryMock.DeleteAll mock is already set by Set")
 	}
@@ -172,12 +177,12 @@ func (mmDeleteAll *mRepositoryMockDeleteAll) Return() *RepositoryMock {
 	if mmDeleteAll.defaultExpectation == nil {
 		mmDeleteAll.defaultExpectation = &RepositoryMockDeleteAllExpectation{mock: mmDeleteAll.mock}
 	}
-
+	mmDeleteAll.defaultExpectation.results = &RepositoryMockDeleteAllResults{err}
 	return mmDeleteAll.mock
 }
 
 // Set uses given function f to mock the repository.DeleteAll method
-func (mmDeleteAll *mRepositoryMockDeleteAll) Set(f func(ctx context.Context, userID int64)) *RepositoryMock {
+func (mmDeleteAll *mRepositoryMockDeleteAll) Set(f func(ctx context.Context, userID int64) (err error)) *RepositoryMock {
 	if mmDeleteAll.defaultExpectation != nil {
 		mmDeleteAll.mock.t.Fatalf("Default expectation is already set for the repository.DeleteAll method")
 	}
@@ -190,6 +195,27 @@ func (mmDeleteAll *mRepositoryMockDeleteAll) Set(f func(ctx context.Context, use
 	return mmDeleteAll.mock
 }
 
+// When sets expectation for the repository.DeleteAll which will trigger the result defined by the following
+// Then helper
+func (mmDeleteAll *mRepositoryMockDeleteAll) When(ctx context.Context, userID int64) *RepositoryMockDeleteAllExpectation {
+	if mmDeleteAll.mock.funcDeleteAll != nil {
+		mmDeleteAll.mock.t.Fatalf("RepositoryMock.DeleteAll mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteAllExpectation{
+		mock:   mmDeleteAll.mock,
+		params: &RepositoryMockDeleteAllParams{ctx, userID},
+	}
+	mmDeleteAll.expectations = append(mmDeleteAll.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.DeleteAll return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteAllExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteAllResults{err}
+	return e.mock
+}
+
 // Times sets number of times repository.DeleteAll should be invoked
 func (mmDeleteAll *mRepositoryMockDeleteAll) Times(n uint64) *mRepositoryMockDeleteAll {
 	if n == 0 {
@@ -211,7 +237,7 @@ func (mmDeleteAll *mRepositoryMockDeleteAll) invocationsDone() bool {
 }
 
 // DeleteAll implements delete.repository
-func (mmDeleteAll *RepositoryMock) DeleteAll(ctx context.Context, userID int64) {
+func (mmDeleteAll *RepositoryMock) DeleteAll(ctx context.Context, userID int64) (err error) {
 	mm_atomic.AddUint64(&mmDeleteAll.beforeDeleteAllCounter, 1)
 	defer mm_atomic.AddUint64(&mmDeleteAll.afterDeleteAllCounter, 1)
 
@@ -229,7 +255,7 @@ func (mmDeleteAll *RepositoryMock) DeleteAll(ctx context.Context, userID int64)
 	for _, e := range mmDeleteAll.DeleteAllMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return
+			return e.results.err
 		}
 	}
 
@@ -254,15 +280,17 @@ func (mmDeleteAll *RepositoryMock) DeleteAll(ctx context.Context, userID int64)
 			mmDeleteAll.t.Errorf("RepositoryMock.DeleteAll got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		return
-
+		mm_results := mmDeleteAll.DeleteAllMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteAll.t.Fatal("No results are set for the RepositoryMock.DeleteAll")
+		}
+		return (*mm_results).err
 	}
 	if mmDeleteAll.funcDeleteAll != nil {
-		mmDeleteAll.funcDeleteAll(ctx, userID)
-		return
+		return mmDeleteAll.funcDeleteAll(ctx, userID)
 	}
 	mmDeleteAll.t.Fatalf("Unexpected call to RepositoryMock.DeleteAll. %v %v", ctx, userID)
-
+	return
 }
 
 // DeleteAllAfterCounter returns a count of finished RepositoryMock.DeleteAll invocations
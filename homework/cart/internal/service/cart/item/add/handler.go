@@ -18,7 +18,8 @@ type (
 	}
 
 	repository interface {
-		Add(ctx context.Context, userID int64, item domain.Item)
+		Add(ctx context.Context, userID int64, item domain.Item) error
+		GetCount(ctx context.Context, userID, skuID int64) (uint16, error)
 	}
 
 	lomsService interface {
@@ -35,6 +36,8 @@ type (
 var (
 	ErrInvalidSKU         = errors.New("invalid sku")
 	ErrInsufficientStocks = errors.New("insufficient stocks")
+	ErrGetCartItemCount   = errors.New("failed to get cart item count")
+	ErrAddCartItem        = errors.New("failed to add cart item")
 )
 
 func New(repo repository, productService productService, lomsService lomsService) *Handler {
@@ -63,11 +66,19 @@ func (h *Handler) AddItem(ctx context.Context, userID int64, item domain.Item) e
 		return fmt.Errorf("%w %w", loms.ErrGetStockInfo, err)
 	}
 
-	if count < int(item.Count) {
+	// Сравниваем остаток с итоговым количеством sku в корзине, а не только с добавляемым
+	cartCount, err := h.repo.GetCount(ctx, userID, item.SKU)
+	if err != nil {
+		return fmt.Errorf("%w %w", ErrGetCartItemCount, err)
+	}
+
+	if count < int(cartCount)+int(item.Count) {
 		return fmt.Errorf("%w", ErrInsufficientStocks)
 	}
 
-	h.repo.Add(ctx, userID, item)
+	if err = h.repo.Add(ctx, userID, item); err != nil {
+		return fmt.Errorf("%w %w", ErrAddCartItem, err)
+	}
 
 	return nil
 }
@@ -99,8 +99,9 @@ func TestAddItemTableWithPrepare(t *testing.T) {
 				f.repMock.AddMock.ExpectUserIDParam2(123).ExpectItemParam3(domain.Item{
 					SKU:   100,
 					Count: 2,
-				}).Return()
+				}).Return(nil)
 				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(4, nil)
+				f.repMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(2, nil)
 				f.repMock.AddMock.Times(1)
 			},
 			wantErr: nil,
@@ -119,9 +120,62 @@ func TestAddItemTableWithPrepare(t *testing.T) {
 					Price: 300,
 				}, nil)
 				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(1, nil)
+				f.repMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(0, nil)
 			},
 			wantErr: ErrInsufficientStocks,
 		},
+		{
+			name:   "not enough stock with items already in cart",
+			userID: 123,
+			item: domain.Item{
+				SKU:   100,
+				Count: 20,
+			},
+			prepare: func(f *fields) {
+				f.productMock.GetProductInfoMock.ExpectSkuParam2(100).Return(&domain.Product{
+					Name:  "Книга",
+					Price: 300,
+				}, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(30, nil)
+				f.repMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(20, nil)
+			},
+			wantErr: ErrInsufficientStocks,
+		},
+		{
+			name:   "repository returned error on get count",
+			userID: 123,
+			item: domain.Item{
+				SKU:   100,
+				Count: 2,
+			},
+			prepare: func(f *fields) {
+				f.productMock.GetProductInfoMock.ExpectSkuParam2(100).Return(&domain.Product{
+					Name:  "Книга",
+					Price: 300,
+				}, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(30, nil)
+				f.repMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(0, fmt.Errorf("test error"))
+			},
+			wantErr: ErrGetCartItemCount,
+		},
+		{
+			name:   "storage returned error",
+			userID: 123,
+			item: domain.Item{
+				SKU:   100,
+				Count: 2,
+			},
+			prepare: func(f *fields) {
+				f.productMock.GetProductInfoMock.ExpectSkuParam2(100).Return(&domain.Product{
+					Name:  "Книга",
+					Price: 300,
+				}, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(4, nil)
+				f.repMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(0, nil)
+				f.repMock.AddMock.ExpectUserIDParam2(123).Return(fmt.Errorf("test error"))
+			},
+			wantErr: ErrAddCartItem,
+		},
 	}
 
 	for _, tt := range testData {
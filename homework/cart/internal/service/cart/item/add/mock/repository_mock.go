@@ -19,11 +19,17 @@ type RepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcAdd          func(ctx context.Context, userID int64, item domain.Item)
+	funcAdd          func(ctx context.Context, userID int64, item domain.Item) (err error)
 	inspectFuncAdd   func(ctx context.Context, userID int64, item domain.Item)
 	afterAddCounter  uint64
 	beforeAddCounter uint64
 	AddMock          mRepositoryMockAdd
+
+	funcGetCount          func(ctx context.Context, userID int64, skuID int64) (u1 uint16, err error)
+	inspectFuncGetCount   func(ctx context.Context, userID int64, skuID int64)
+	afterGetCountCounter  uint64
+	beforeGetCountCounter uint64
+	GetCountMock          mRepositoryMockGetCount
 }
 
 // NewRepositoryMock returns a mock for add.repository
@@ -37,6 +43,9 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 	m.AddMock = mRepositoryMockAdd{mock: m}
 	m.AddMock.callArgs = []*RepositoryMockAddParams{}
 
+	m.GetCountMock = mRepositoryMockGetCount{mock: m}
+	m.GetCountMock.callArgs = []*RepositoryMockGetCountParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -59,8 +68,8 @@ type RepositoryMockAddExpectation struct {
 	mock      *RepositoryMock
 	params    *RepositoryMockAddParams
 	paramPtrs *RepositoryMockAddParamPtrs
-
-	Counter uint64
+	results   *RepositoryMockAddResults
+	Counter   uint64
 }
 
 // RepositoryMockAddParams contains parameters of the repository.Add
@@ -77,6 +86,11 @@ type RepositoryMockAddParamPtrs struct {
 	item   *domain.Item
 }
 
+// RepositoryMockAddResults contains results of the repository.Add
+type RepositoryMockAddResults struct {
+	err error
+}
+
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
 // the test will fail minimock's automatic final call check if the mocked method was not called at least once.
 // Optional() makes method check to work in '0 or more' mode.
@@ -189,7 +203,7 @@ func (mmAdd *mRepositoryMockAdd) Inspect(f func(ctx context.Context, userID int6
 }
 
 // Return sets up results that will be returned by repository.Add
-func (mmAdd *mRepositoryMockAdd) Return() *RepositoryMock {
+func (mmAdd *mRepositoryMockAdd) Return(err error) *RepositoryMock {
 	if mmAdd.mock.funcAdd != nil {
 		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Set")
 	}
@@ -197,12 +211,12 @@ func (mmAdd *mRepositoryMockAdd) Return() *RepositoryMock {
 	if mmAdd.defaultExpectation == nil {
 		mmAdd.defaultExpectation = &RepositoryMockAddExpectation{mock: mmAdd.mock}
 	}
-
+	mmAdd.defaultExpectation.results = &RepositoryMockAddResults{err}
 	return mmAdd.mock
 }
 
 // Set uses given function f to mock the repository.Add method
-func (mmAdd *mRepositoryMockAdd) Set(f func(ctx context.Context, userID int64, item domain.Item)) *RepositoryMock {
+func (mmAdd *mRepositoryMockAdd) Set(f func(ctx context.Context, userID int64, item domain.Item) (err error)) *RepositoryMock {
 	if mmAdd.defaultExpectation != nil {
 		mmAdd.mock.t.Fatalf("Default expectation is already set for the repository.Add method")
 	}
@@ -215,6 +229,27 @@ func (mmAdd *mRepositoryMockAdd) Set(f func(ctx context.Context, userID int64, i
 	return mmAdd.mock
 }
 
+// When sets expectation for the repository.Add which will trigger the result defined by the following
+// Then helper
+func (mmAdd *mRepositoryMockAdd) When(ctx context.Context, userID int64, item domain.Item) *RepositoryMockAddExpectation {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("RepositoryMock.Add mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockAddExpectation{
+		mock:   mmAdd.mock,
+		params: &RepositoryMockAddParams{ctx, userID, item},
+	}
+	mmAdd.expectations = append(mmAdd.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.Add return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockAddExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockAddResults{err}
+	return e.mock
+}
+
 // Times sets number of times repository.Add should be invoked
 func (mmAdd *mRepositoryMockAdd) Times(n uint64) *mRepositoryMockAdd {
 	if n == 0 {
@@ -236,7 +271,7 @@ func (mmAdd *mRepositoryMockAdd) invocationsDone() bool {
 }
 
 // Add implements add.repository
-func (mmAdd *RepositoryMock) Add(ctx context.Context, userID int64, item domain.Item) {
+func (mmAdd *RepositoryMock) Add(ctx context.Context, userID int64, item domain.Item) (err error) {
 	mm_atomic.AddUint64(&mmAdd.beforeAddCounter, 1)
 	defer mm_atomic.AddUint64(&mmAdd.afterAddCounter, 1)
 
@@ -254,7 +289,7 @@ func (mmAdd *RepositoryMock) Add(ctx context.Context, userID int64, item domain.
 	for _, e := range mmAdd.AddMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return
+			return e.results.err
 		}
 	}
 
@@ -283,15 +318,17 @@ func (mmAdd *RepositoryMock) Add(ctx context.Context, userID int64, item domain.
 			mmAdd.t.Errorf("RepositoryMock.Add got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		return
-
+		mm_results := mmAdd.AddMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAdd.t.Fatal("No results are set for the RepositoryMock.Add")
+		}
+		return (*mm_results).err
 	}
 	if mmAdd.funcAdd != nil {
-		mmAdd.funcAdd(ctx, userID, item)
-		return
+		return mmAdd.funcAdd(ctx, userID, item)
 	}
 	mmAdd.t.Fatalf("Unexpected call to RepositoryMock.Add. %v %v %v", ctx, userID, item)
-
+	return
 }
 
 // AddAfterCounter returns a count of finished RepositoryMock.Add invocations
@@ -362,11 +399,362 @@ func (m *RepositoryMock) MinimockAddInspect() {
 	}
 }
 
+type mRepositoryMockGetCount struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetCountExpectation
+	expectations       []*RepositoryMockGetCountExpectation
+
+	callArgs []*RepositoryMockGetCountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockGetCountExpectation specifies expectation struct of the repository.GetCount
+type RepositoryMockGetCountExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockGetCountParams
+	paramPtrs *RepositoryMockGetCountParamPtrs
+	results   *RepositoryMockGetCountResults
+	Counter   uint64
+}
+
+// RepositoryMockGetCountParams contains parameters of the repository.GetCount
+type RepositoryMockGetCountParams struct {
+	ctx    context.Context
+	userID int64
+	skuID  int64
+}
+
+// RepositoryMockGetCountParamPtrs contains pointers to parameters of the repository.GetCount
+type RepositoryMockGetCountParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	skuID  *int64
+}
+
+// RepositoryMockGetCountResults contains results of the repository.GetCount
+type RepositoryMockGetCountResults struct {
+	u1  uint16
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option by default unless you really need it, as it helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCount *mRepositoryMockGetCount) Optional() *mRepositoryMockGetCount {
+	mmGetCount.optional = true
+	return mmGetCount
+}
+
+// Expect sets up expected params for repository.GetCount
+func (mmGetCount *mRepositoryMockGetCount) Expect(ctx context.Context, userID int64, skuID int64) *mRepositoryMockGetCount {
+	if mmGetCount.mock.funcGetCount != nil {
+		mmGetCount.mock.t.Fatalf("RepositoryMock.GetCount mock is already set by Set")
+	}
+
+	if mmGetCount.defaultExpectation == nil {
+		mmGetCount.defaultExpectation = &RepositoryMockGetCountExpectation{}
+	}
+
+	if mmGetCount.defaultExpectation.paramPtrs != nil {
+		mmGetCount.mock.t.Fatalf("RepositoryMock.GetCount mock is already set by ExpectParams functions")
+	}
+
+	mmGetCount.defaultExpectation.params = &RepositoryMockGetCountParams{ctx, userID, skuID}
+	for _, e := range mmGetCount.expectations {
+		if minimock.Equal(e.params, mmGetCount.defaultExpectation.params) {
+			mmGetCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCount.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetCount
+func (mmGetCount *mRepositoryMockGetCount) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetCount {
+	if mmGetCount.mock.funcGetCount != nil {
+		mmGetCount.mock.t.Fatalf("RepositoryMock.GetCount mock is already set by Set")
+	}
+
+	if mmGetCount.defaultExpectation == nil {
+		mmGetCount.defaultExpectation = &RepositoryMockGetCountExpectation{}
+	}
+
+	if mmGetCount.defaultExpectation.params != nil {
+		mmGetCount.mock.t.Fatalf("RepositoryMock.GetCount mock is already set by Expect")
+	}
+
+	if mmGetCount.defaultExpectation.paramPtrs == nil {
+		mmGetCount.defaultExpectation.paramPtrs = &RepositoryMockGetCountParamPtrs{}
+	}
+	mmGetCount.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetCount
+}
+
+// ExpectUserIDParam2 sets up expected param userID for repository.GetCount
+func (mmGetCount *mRepositoryMockGetCount) ExpectUserIDParam2(userID int64) *mRepositoryMockGetCount {
+	if mmGetCount.mock.funcGetCount != nil {
+		mmGetCount.mock.t.Fatalf("RepositoryMock.GetCount mock is already set by Set")
+	}
+
+	if mmGetCount.defaultExpectation == nil {
+		mmGetCount.defaultExpectation = &RepositoryMockGetCountExpectation{}
+	}
+
+	if mmGetCount.defaultExpectation.params != nil {
+		mmGetCount.mock.t.Fatalf("RepositoryMock.GetCount mock is already set by Expect")
+	}
+
+	if mmGetCount.defaultExpectation.paramPtrs == nil {
+		mmGetCount.defaultExpectation.paramPtrs = &RepositoryMockGetCountParamPtrs{}
+	}
+	mmGetCount.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmGetCount
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for repository.GetCount
+func (mmGetCount *mRepositoryMockGetCount) ExpectSkuIDParam3(skuID int64) *mRepositoryMockGetCount {
+	if mmGetCount.mock.funcGetCount != nil {
+		mmGetCount.mock.t.Fatalf("RepositoryMock.GetCount mock is already set by Set")
+	}
+
+	if mmGetCount.defaultExpectation == nil {
+		mmGetCount.defaultExpectation = &RepositoryMockGetCountExpectation{}
+	}
+
+	if mmGetCount.defaultExpectation.params != nil {
+		mmGetCount.mock.t.Fatalf("RepositoryMock.GetCount mock is already set by Expect")
+	}
+
+	if mmGetCount.defaultExpectation.paramPtrs == nil {
+		mmGetCount.defaultExpectation.paramPtrs = &RepositoryMockGetCountParamPtrs{}
+	}
+	mmGetCount.defaultExpectation.paramPtrs.skuID = &skuID
+
+	return mmGetCount
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetCount
+func (mmGetCount *mRepositoryMockGetCount) Inspect(f func(ctx context.Context, userID int64, skuID int64)) *mRepositoryMockGetCount {
+	if mmGetCount.mock.inspectFuncGetCount != nil {
+		mmGetCount.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetCount")
+	}
+
+	mmGetCount.mock.inspectFuncGetCount = f
+
+	return mmGetCount
+}
+
+// Return sets up results that will be returned by repository.GetCount
+func (mmGetCount *mRepositoryMockGetCount) Return(u1 uint16, err error) *RepositoryMock {
+	if mmGetCount.mock.funcGetCount != nil {
+		mmGetCount.mock.t.Fatalf("RepositoryMock.GetCount mock is already set by Set")
+	}
+
+	if mmGetCount.defaultExpectation == nil {
+		mmGetCount.defaultExpectation = &RepositoryMockGetCountExpectation{mock: mmGetCount.mock}
+	}
+	mmGetCount.defaultExpectation.results = &RepositoryMockGetCountResults{u1, err}
+	return mmGetCount.mock
+}
+
+// Set uses given function f to mock the repository.GetCount method
+func (mmGetCount *mRepositoryMockGetCount) Set(f func(ctx context.Context, userID int64, skuID int64) (u1 uint16, err error)) *RepositoryMock {
+	if mmGetCount.defaultExpectation != nil {
+		mmGetCount.mock.t.Fatalf("Default expectation is already set for the repository.GetCount method")
+	}
+
+	if len(mmGetCount.expectations) > 0 {
+		mmGetCount.mock.t.Fatalf("Some expectations are already set for the repository.GetCount method")
+	}
+
+	mmGetCount.mock.funcGetCount = f
+	return mmGetCount.mock
+}
+
+// When sets expectation for the repository.GetCount which will trigger the result defined by the following
+// Then helper
+func (mmGetCount *mRepositoryMockGetCount) When(ctx context.Context, userID int64, skuID int64) *RepositoryMockGetCountExpectation {
+	if mmGetCount.mock.funcGetCount != nil {
+		mmGetCount.mock.t.Fatalf("RepositoryMock.GetCount mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetCountExpectation{
+		mock:   mmGetCount.mock,
+		params: &RepositoryMockGetCountParams{ctx, userID, skuID},
+	}
+	mmGetCount.expectations = append(mmGetCount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetCount return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetCountExpectation) Then(u1 uint16, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetCountResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetCount should be invoked
+func (mmGetCount *mRepositoryMockGetCount) Times(n uint64) *mRepositoryMockGetCount {
+	if n == 0 {
+		mmGetCount.mock.t.Fatalf("Times of RepositoryMock.GetCount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCount.expectedInvocations, n)
+	return mmGetCount
+}
+
+func (mmGetCount *mRepositoryMockGetCount) invocationsDone() bool {
+	if len(mmGetCount.expectations) == 0 && mmGetCount.defaultExpectation == nil && mmGetCount.mock.funcGetCount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCount.mock.afterGetCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCount implements add.repository
+func (mmGetCount *RepositoryMock) GetCount(ctx context.Context, userID int64, skuID int64) (u1 uint16, err error) {
+	mm_atomic.AddUint64(&mmGetCount.beforeGetCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCount.afterGetCountCounter, 1)
+
+	if mmGetCount.inspectFuncGetCount != nil {
+		mmGetCount.inspectFuncGetCount(ctx, userID, skuID)
+	}
+
+	mm_params := RepositoryMockGetCountParams{ctx, userID, skuID}
+
+	// Record call args
+	mmGetCount.GetCountMock.mutex.Lock()
+	mmGetCount.GetCountMock.callArgs = append(mmGetCount.GetCountMock.callArgs, &mm_params)
+	mmGetCount.GetCountMock.mutex.Unlock()
+
+	for _, e := range mmGetCount.GetCountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmGetCount.GetCountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCount.GetCountMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCount.GetCountMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCount.GetCountMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetCountParams{ctx, userID, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCount.t.Errorf("RepositoryMock.GetCount got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetCount.t.Errorf("RepositoryMock.GetCount got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetCount.t.Errorf("RepositoryMock.GetCount got unexpected parameter skuID, want: %#v, got: %#v%s\n", *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCount.t.Errorf("RepositoryMock.GetCount got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCount.GetCountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCount.t.Fatal("No results are set for the RepositoryMock.GetCount")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmGetCount.funcGetCount != nil {
+		return mmGetCount.funcGetCount(ctx, userID, skuID)
+	}
+	mmGetCount.t.Fatalf("Unexpected call to RepositoryMock.GetCount. %v %v %v", ctx, userID, skuID)
+	return
+}
+
+// GetCountAfterCounter returns a count of finished RepositoryMock.GetCount invocations
+func (mmGetCount *RepositoryMock) GetCountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCount.afterGetCountCounter)
+}
+
+// GetCountBeforeCounter returns a count of RepositoryMock.GetCount invocations
+func (mmGetCount *RepositoryMock) GetCountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCount.beforeGetCountCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetCount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCount *mRepositoryMockGetCount) Calls() []*RepositoryMockGetCountParams {
+	mmGetCount.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetCountParams, len(mmGetCount.callArgs))
+	copy(argCopy, mmGetCount.callArgs)
+
+	mmGetCount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCountDone returns true if the count of the GetCount invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetCountDone() bool {
+	if m.GetCountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCountMock.invocationsDone()
+}
+
+// MinimockGetCountInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetCountInspect() {
+	for _, e := range m.GetCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetCount with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCountCounter := mm_atomic.LoadUint64(&m.afterGetCountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCountMock.defaultExpectation != nil && afterGetCountCounter < 1 {
+		if m.GetCountMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.GetCount")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetCount with params: %#v", *m.GetCountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCount != nil && afterGetCountCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.GetCount")
+	}
+
+	if !m.GetCountMock.invocationsDone() && afterGetCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetCount but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCountMock.expectedInvocations), afterGetCountCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *RepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockAddInspect()
+
+			m.MinimockGetCountInspect()
 			m.t.FailNow()
 		}
 	})
@@ -391,5 +779,6 @@ func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *RepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockAddDone()
+		m.MinimockAddDone() &&
+		m.MinimockGetCountDone()
 }
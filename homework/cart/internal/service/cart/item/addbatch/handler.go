@@ -0,0 +1,214 @@
+package addbatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+
+	"route256/cart/internal/app/errgroup"
+	"route256/cart/internal/clients/loms"
+	"route256/cart/internal/clients/product"
+	"route256/cart/internal/domain"
+)
+
+type (
+	productService interface {
+		GetProductInfo(ctx context.Context, sku uint32) (*domain.Product, error)
+	}
+
+	repository interface {
+		Add(ctx context.Context, userID int64, item domain.Item) error
+		GetCount(ctx context.Context, userID, skuID int64) (uint16, error)
+	}
+
+	lomsService interface {
+		InfoStocks(ctx context.Context, SKU int64) (int, error)
+	}
+
+	limiter interface {
+		Wait(ctx context.Context) error
+	}
+
+	txManager interface {
+		RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	Handler struct {
+		productService productService
+		repo           repository
+		lomsService    lomsService
+		limiter        limiter
+		txManager      txManager
+	}
+
+	// ItemFailure describes why an item of the batch was rejected.
+	ItemFailure struct {
+		SKU int64
+		Err error
+	}
+
+	// RejectedError is returned when at least one item of the batch failed validation, in that case none of the items are added.
+	RejectedError struct {
+		Failures []ItemFailure
+	}
+)
+
+func (e RejectedError) Error() string {
+	return fmt.Sprintf("batch rejected: %d item(s) failed validation", len(e.Failures))
+}
+
+var (
+	ErrInvalidSKU         = errors.New("invalid sku")
+	ErrInsufficientStocks = errors.New("insufficient stocks")
+	ErrGetCartItemCount   = errors.New("failed to get cart item count")
+	ErrAddCartItem        = errors.New("failed to add cart item")
+	ErrCountOverflow      = errors.New("item count exceeds the maximum")
+	ErrTooManyItems       = errors.New("too many items in batch")
+)
+
+// MaxItems bounds the batch, each item costs a product and a stocks request under the rate limiters
+const MaxItems = 100
+
+func New(repo repository, productService productService, lomsService lomsService, limiter limiter, txManager txManager) *Handler {
+	return &Handler{
+		repo:           repo,
+		productService: productService,
+		lomsService:    lomsService,
+		limiter:        limiter,
+		txManager:      txManager,
+	}
+}
+
+// AddItems validates all items concurrently and adds them to the user cart only if every item passed validation.
+func (h *Handler) AddItems(ctx context.Context, userID int64, items []domain.Item) error {
+	ctx, span := otel.Tracer("cart").Start(ctx, "service_add_items")
+	defer span.End()
+
+	if len(items) > MaxItems {
+		return fmt.Errorf("%w: %d items, at most %d allowed", ErrTooManyItems, len(items), MaxItems)
+	}
+
+	items, failures := mergeItems(items)
+
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	var mx sync.Mutex
+
+	for _, item := range items {
+		eg.Go(func() error {
+			if err := h.limiter.Wait(egCtx); err != nil {
+				return fmt.Errorf("rate limiter error: %w", err)
+			}
+
+			if err := h.validateItem(egCtx, userID, item); err != nil {
+				mx.Lock()
+				failures = append(failures, ItemFailure{SKU: item.SKU, Err: err})
+				mx.Unlock()
+			}
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	if len(failures) > 0 {
+		sort.Slice(failures, func(i, j int) bool {
+			return failures[i].SKU < failures[j].SKU
+		})
+
+		return RejectedError{Failures: failures}
+	}
+
+	// Добавление в одной транзакции, иначе сбой на середине оставит в корзине часть пачки
+	return h.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		for _, item := range items {
+			if err := h.repo.Add(ctx, userID, item); err != nil {
+				return fmt.Errorf("sku %d: %w %w", item.SKU, ErrAddCartItem, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (h *Handler) validateItem(ctx context.Context, userID int64, item domain.Item) error {
+	products, err := h.productService.GetProductInfo(ctx, uint32(item.SKU))
+	if err != nil {
+		return fmt.Errorf("%w %w", product.ErrGetProductInfo, err)
+	}
+
+	if products == nil {
+		return fmt.Errorf("ProductService.GetProductInfo return no product with given SKU=%d: %w", item.SKU, ErrInvalidSKU)
+	}
+
+	count, err := h.lomsService.InfoStocks(ctx, item.SKU)
+	if err != nil {
+		return fmt.Errorf("%w %w", loms.ErrGetStockInfo, err)
+	}
+
+	cartCount, err := h.repo.GetCount(ctx, userID, item.SKU)
+	if err != nil {
+		return fmt.Errorf("%w %w", ErrGetCartItemCount, err)
+	}
+
+	if int(cartCount)+int(item.Count) > math.MaxUint16 {
+		return fmt.Errorf("%w: %d in cart", ErrCountOverflow, cartCount)
+	}
+
+	if count < int(cartCount)+int(item.Count) {
+		return fmt.Errorf("%w", ErrInsufficientStocks)
+	}
+
+	return nil
+}
+
+// mergeItems sums counts of repeated skus, so the stock is checked against the total requested count.
+// Skus whose total count doesn't fit the item count are left out and returned as failures.
+func mergeItems(items []domain.Item) ([]domain.Item, []ItemFailure) {
+	positions := make(map[int64]int, len(items))
+	merged := make([]domain.Item, 0, len(items))
+	overflowed := make(map[int64]struct{})
+
+	for _, item := range items {
+		idx, ok := positions[item.SKU]
+		if !ok {
+			positions[item.SKU] = len(merged)
+			merged = append(merged, item)
+
+			continue
+		}
+
+		if int(merged[idx].Count)+int(item.Count) > math.MaxUint16 {
+			overflowed[item.SKU] = struct{}{}
+			continue
+		}
+
+		merged[idx].Count += item.Count
+	}
+
+	if len(overflowed) == 0 {
+		return merged, nil
+	}
+
+	valid := make([]domain.Item, 0, len(merged))
+	failures := make([]ItemFailure, 0, len(overflowed))
+
+	for _, item := range merged {
+		if _, ok := overflowed[item.SKU]; ok {
+			failures = append(failures, ItemFailure{SKU: item.SKU, Err: ErrCountOverflow})
+			continue
+		}
+
+		valid = append(valid, item)
+	}
+
+	return valid, failures
+}
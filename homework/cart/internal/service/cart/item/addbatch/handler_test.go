@@ -0,0 +1,236 @@
+package addbatch
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+	"golang.org/x/time/rate"
+
+	"route256/cart/internal/clients/product"
+	"route256/cart/internal/domain"
+	"route256/cart/internal/service/cart/item/addbatch/mock"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestAddItemsTableWithPrepare(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type (
+		fields struct {
+			productMock *mock.ProductServiceMock
+			repMock     *mock.RepositoryMock
+			lomsMock    *mock.LomsServiceMock
+			txMock      *mock.TxManagerMock
+		}
+
+		data struct {
+			name         string
+			userID       int64
+			items        []domain.Item
+			prepare      func(f *fields)
+			wantFailures []ItemFailure
+		}
+	)
+
+	book := &domain.Product{
+		Name:  "Книга",
+		Price: 300,
+	}
+
+	runInTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	testData := []data{
+		{
+			name:   "all items are valid",
+			userID: 123,
+			items: []domain.Item{
+				{SKU: 100, Count: 2},
+				{SKU: 200, Count: 1},
+			},
+			prepare: func(f *fields) {
+				f.productMock.GetProductInfoMock.Set(func(_ context.Context, _ uint32) (*domain.Product, error) {
+					return book, nil
+				})
+				f.lomsMock.InfoStocksMock.Set(func(_ context.Context, _ int64) (int, error) {
+					return 10, nil
+				})
+				f.repMock.GetCountMock.Set(func(_ context.Context, _, _ int64) (uint16, error) {
+					return 0, nil
+				})
+				f.txMock.RunInTxMock.Set(runInTx)
+				f.repMock.AddMock.Times(2).Return(nil)
+			},
+		},
+		{
+			name:   "repeated skus are merged before stock check",
+			userID: 123,
+			items: []domain.Item{
+				{SKU: 100, Count: 6},
+				{SKU: 100, Count: 6},
+			},
+			prepare: func(f *fields) {
+				f.productMock.GetProductInfoMock.ExpectSkuParam2(100).Return(book, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(10, nil)
+				f.repMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(0, nil)
+			},
+			wantFailures: []ItemFailure{{SKU: 100, Err: ErrInsufficientStocks}},
+		},
+		{
+			name:   "one invalid item rejects the whole batch",
+			userID: 123,
+			items: []domain.Item{
+				{SKU: 200, Count: 1},
+				{SKU: 100, Count: 2},
+				{SKU: 300, Count: 5},
+			},
+			prepare: func(f *fields) {
+				f.productMock.GetProductInfoMock.Set(func(_ context.Context, sku uint32) (*domain.Product, error) {
+					switch sku {
+					case 100:
+						return nil, nil
+					case 300:
+						return nil, fmt.Errorf("test error")
+					}
+
+					return book, nil
+				})
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(200).Return(10, nil)
+				f.repMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(200).Return(0, nil)
+			},
+			wantFailures: []ItemFailure{
+				{SKU: 100, Err: ErrInvalidSKU},
+				{SKU: 300, Err: product.ErrGetProductInfo},
+			},
+		},
+		{
+			name:   "items already in cart are taken into account",
+			userID: 123,
+			items: []domain.Item{
+				{SKU: 100, Count: 20},
+			},
+			prepare: func(f *fields) {
+				f.productMock.GetProductInfoMock.ExpectSkuParam2(100).Return(book, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(30, nil)
+				f.repMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(20, nil)
+			},
+			wantFailures: []ItemFailure{{SKU: 100, Err: ErrInsufficientStocks}},
+		},
+		{
+			name:   "merged count overflow rejects the batch",
+			userID: 123,
+			items: []domain.Item{
+				{SKU: 100, Count: 40000},
+				{SKU: 200, Count: 1},
+				{SKU: 100, Count: 40000},
+			},
+			prepare: func(f *fields) {
+				// Переполненный sku не проверяется, остальные проверяются как обычно
+				f.productMock.GetProductInfoMock.ExpectSkuParam2(200).Return(book, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(200).Return(10, nil)
+				f.repMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(200).Return(0, nil)
+			},
+			wantFailures: []ItemFailure{{SKU: 100, Err: ErrCountOverflow}},
+		},
+		{
+			name:   "count overflow with items already in cart",
+			userID: 123,
+			items: []domain.Item{
+				{SKU: 100, Count: 40000},
+			},
+			prepare: func(f *fields) {
+				f.productMock.GetProductInfoMock.ExpectSkuParam2(100).Return(book, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(100000, nil)
+				f.repMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(40000, nil)
+			},
+			wantFailures: []ItemFailure{{SKU: 100, Err: ErrCountOverflow}},
+		},
+	}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			fieldsForTableTest := fields{
+				productMock: mock.NewProductServiceMock(ctrl),
+				repMock:     mock.NewRepositoryMock(ctrl),
+				lomsMock:    mock.NewLomsServiceMock(ctrl),
+				txMock:      mock.NewTxManagerMock(ctrl),
+			}
+
+			addBatchHandler := New(fieldsForTableTest.repMock, fieldsForTableTest.productMock, fieldsForTableTest.lomsMock,
+				rate.NewLimiter(rate.Inf, 1), fieldsForTableTest.txMock)
+
+			tt.prepare(&fieldsForTableTest)
+			err := addBatchHandler.AddItems(ctx, tt.userID, tt.items)
+
+			if len(tt.wantFailures) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var rejectedErr RejectedError
+			require.ErrorAs(t, err, &rejectedErr)
+			require.Len(t, rejectedErr.Failures, len(tt.wantFailures))
+
+			for i, failure := range rejectedErr.Failures {
+				require.Equal(t, tt.wantFailures[i].SKU, failure.SKU)
+				require.ErrorIs(t, failure.Err, tt.wantFailures[i].Err)
+			}
+		})
+	}
+}
+
+func TestAddItemsTooManyItems(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	addBatchHandler := New(mock.NewRepositoryMock(ctrl), mock.NewProductServiceMock(ctrl), mock.NewLomsServiceMock(ctrl),
+		rate.NewLimiter(rate.Inf, 1), mock.NewTxManagerMock(ctrl))
+
+	items := make([]domain.Item, MaxItems+1)
+	for i := range items {
+		items[i] = domain.Item{SKU: int64(i + 1), Count: 1}
+	}
+
+	err := addBatchHandler.AddItems(context.Background(), 123, items)
+	require.ErrorIs(t, err, ErrTooManyItems)
+}
+
+func TestAddItemsAddErrorIsReturnedFromTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+
+	productMock := mock.NewProductServiceMock(ctrl)
+	productMock.GetProductInfoMock.ExpectSkuParam2(100).Return(&domain.Product{Name: "Книга", Price: 300}, nil)
+
+	lomsMock := mock.NewLomsServiceMock(ctrl)
+	lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(10, nil)
+
+	repMock := mock.NewRepositoryMock(ctrl)
+	repMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(0, nil)
+	repMock.AddMock.Return(fmt.Errorf("test error"))
+
+	txMock := mock.NewTxManagerMock(ctrl)
+	txMock.RunInTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	addBatchHandler := New(repMock, productMock, lomsMock, rate.NewLimiter(rate.Inf, 1), txMock)
+
+	err := addBatchHandler.AddItems(context.Background(), 123, []domain.Item{{SKU: 100, Count: 2}})
+	require.ErrorIs(t, err, ErrAddCartItem)
+}
@@ -2,13 +2,15 @@ package delete
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/otel"
 )
 
 type (
 	repository interface {
-		DeleteOne(ctx context.Context, userID, skuID int64)
+		DeleteOne(ctx context.Context, userID, skuID int64) error
 	}
 
 	Handler struct {
@@ -16,15 +18,21 @@ type (
 	}
 )
 
+var ErrDeleteCartItem = errors.New("failed to delete cart item")
+
 func New(repo repository) *Handler {
 	return &Handler{
 		repo: repo,
 	}
 }
 
-func (h *Handler) DeleteItem(ctx context.Context, userID, skuID int64) {
+func (h *Handler) DeleteItem(ctx context.Context, userID, skuID int64) error {
 	ctx, span := otel.Tracer("cart").Start(ctx, "service_delete_item")
 	defer span.End()
 
-	h.repo.DeleteOne(ctx, userID, skuID)
+	if err := h.repo.DeleteOne(ctx, userID, skuID); err != nil {
+		return fmt.Errorf("%w %w", ErrDeleteCartItem, err)
+	}
+
+	return nil
 }
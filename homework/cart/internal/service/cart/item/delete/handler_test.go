@@ -2,9 +2,11 @@ package delete
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
 
 	"route256/cart/internal/service/cart/item/delete/mock"
@@ -35,7 +37,22 @@ func TestDeleteItem(t *testing.T) {
 	repMock := mock.NewRepositoryMock(ctrl)
 	deleteHandler := New(repMock)
 
-	repMock.DeleteOneMock.ExpectUserIDParam2(testData.userID).ExpectSkuIDParam3(testData.skuID).Return()
-	deleteHandler.DeleteItem(ctx, testData.userID, testData.skuID)
+	repMock.DeleteOneMock.ExpectUserIDParam2(testData.userID).ExpectSkuIDParam3(testData.skuID).Return(nil)
+	err := deleteHandler.DeleteItem(ctx, testData.userID, testData.skuID)
+	require.NoError(t, err)
 	repMock.DeleteOneMock.Times(1)
 }
+
+func TestDeleteItemStorageError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ctrl := minimock.NewController(t)
+	repMock := mock.NewRepositoryMock(ctrl)
+	deleteHandler := New(repMock)
+
+	repMock.DeleteOneMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(796321).Return(fmt.Errorf("test error"))
+	err := deleteHandler.DeleteItem(ctx, 123, 796321)
+	require.ErrorIs(t, err, ErrDeleteCartItem)
+}
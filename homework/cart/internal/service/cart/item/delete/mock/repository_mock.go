@@ -18,7 +18,7 @@ type RepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcDeleteOne          func(ctx context.Context, userID int64, skuID int64)
+	funcDeleteOne          func(ctx context.Context, userID int64, skuID int64) (err error)
 	inspectFuncDeleteOne   func(ctx context.Context, userID int64, skuID int64)
 	afterDeleteOneCounter  uint64
 	beforeDeleteOneCounter uint64
@@ -58,8 +58,8 @@ type RepositoryMockDeleteOneExpectation struct {
 	mock      *RepositoryMock
 	params    *RepositoryMockDeleteOneParams
 	paramPtrs *RepositoryMockDeleteOneParamPtrs
-
-	Counter uint64
+	results   *RepositoryMockDeleteOneResults
+	Counter   uint64
 }
 
 // RepositoryMockDeleteOneParams contains parameters of the repository.DeleteOne
@@ -76,6 +76,11 @@ type RepositoryMockDeleteOneParamPtrs struct {
 	skuID  *int64
 }
 
+// RepositoryMockDeleteOneResults contains results of the repository.DeleteOne
+type RepositoryMockDeleteOneResults struct {
+	err error
+}
+
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
 // the test will fail minimock's automatic final call check if the mocked method was not called at least once.
 // Optional() makes method check to work in '0 or more' mode.
@@ -188,7 +193,7 @@ func (mmDeleteOne *mRepositoryMockDeleteOne) Inspect(f func(ctx context.Context,
 }
 
 // Return sets up results that will be returned by repository.DeleteOne
-func (mmDeleteOne *mRepositoryMockDeleteOne) Return() *RepositoryMock {
+func (mmDeleteOne *mRepositoryMockDeleteOne) Return(err error) *RepositoryMock {
 	if mmDeleteOne.mock.funcDeleteOne != nil {
 		mmDeleteOne.mock.t.Fatalf("RepositoryMock.DeleteOne mock is already set by Set")
 	}
@@ -196,12 +201,12 @@ func (mmDeleteOne *mRepositoryMockDeleteOne) Return() *RepositoryMock {
 	if mmDeleteOne.defaultExpectation == nil {
 		mmDeleteOne.defaultExpectation = &RepositoryMockDeleteOneExpectation{mock: mmDeleteOne.mock}
 	}
-
+	mmDeleteOne.defaultExpectation.results = &RepositoryMockDeleteOneResults{err}
 	return mmDeleteOne.mock
 }
 
 // Set uses given function f to mock the repository.DeleteOne method
-func (mmDeleteOne *mRepositoryMockDeleteOne) Set(f func(ctx context.Context, userID int64, skuID int64)) *RepositoryMock {
+func (mmDeleteOne *mRepositoryMockDeleteOne) Set(f func(ctx context.Context, userID int64, skuID int64) (err error)) *RepositoryMock {
 	if mmDeleteOne.defaultExpectation != nil {
 		mmDeleteOne.mock.t.Fatalf("Default expectation is already set for the repository.DeleteOne method")
 	}
@@ -214,6 +219,27 @@ func (mmDeleteOne *mRepositoryMockDeleteOne) Set(f func(ctx context.Context, use
 	return mmDeleteOne.mock
 }
 
+// When sets expectation for the repository.DeleteOne which will trigger the result defined by the following
+// Then helper
+func (mmDeleteOne *mRepositoryMockDeleteOne) When(ctx context.Context, userID int64, skuID int64) *RepositoryMockDeleteOneExpectation {
+	if mmDeleteOne.mock.funcDeleteOne != nil {
+		mmDeleteOne.mock.t.Fatalf("RepositoryMock.DeleteOne mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteOneExpectation{
+		mock:   mmDeleteOne.mock,
+		params: &RepositoryMockDeleteOneParams{ctx, userID, skuID},
+	}
+	mmDeleteOne.expectations = append(mmDeleteOne.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.DeleteOne return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteOneExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteOneResults{err}
+	return e.mock
+}
+
 // Times sets number of times repository.DeleteOne should be invoked
 func (mmDeleteOne *mRepositoryMockDeleteOne) Times(n uint64) *mRepositoryMockDeleteOne {
 	if n == 0 {
@@ -235,7 +261,7 @@ func (mmDeleteOne *mRepositoryMockDeleteOne) invocationsDone() bool {
 }
 
 // DeleteOne implements delete.repository
-func (mmDeleteOne *RepositoryMock) DeleteOne(ctx context.Context, userID int64, skuID int64) {
+func (mmDeleteOne *RepositoryMock) DeleteOne(ctx context.Context, userID int64, skuID int64) (err error) {
 	mm_atomic.AddUint64(&mmDeleteOne.beforeDeleteOneCounter, 1)
 	defer mm_atomic.AddUint64(&mmDeleteOne.afterDeleteOneCounter, 1)
 
@@ -253,7 +279,7 @@ func (mmDeleteOne *RepositoryMock) DeleteOne(ctx context.Context, userID int64,
 	for _, e := range mmDeleteOne.DeleteOneMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return
+			return e.results.err
 		}
 	}
 
@@ -282,15 +308,17 @@ func (mmDeleteOne *RepositoryMock) DeleteOne(ctx context.Context, userID int64,
 			mmDeleteOne.t.Errorf("RepositoryMock.DeleteOne got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		return
-
+		mm_results := mmDeleteOne.DeleteOneMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteOne.t.Fatal("No results are set for the RepositoryMock.DeleteOne")
+		}
+		return (*mm_results).err
 	}
 	if mmDeleteOne.funcDeleteOne != nil {
-		mmDeleteOne.funcDeleteOne(ctx, userID, skuID)
-		return
+		return mmDeleteOne.funcDeleteOne(ctx, userID, skuID)
 	}
 	mmDeleteOne.t.Fatalf("Unexpected call to RepositoryMock.DeleteOne. %v %v %v", ctx, userID, skuID)
-
+	return
 }
 
 // DeleteOneAfterCounter returns a count of finished RepositoryMock.DeleteOne invocations
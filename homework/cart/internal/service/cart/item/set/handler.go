@@ -0,0 +1,87 @@
+package set
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+
+	"route256/cart/internal/clients/loms"
+	"route256/cart/internal/clients/product"
+	"route256/cart/internal/domain"
+)
+
+type (
+	productService interface {
+		GetProductInfo(ctx context.Context, sku uint32) (*domain.Product, error)
+	}
+
+	repository interface {
+		Set(ctx context.Context, userID int64, item domain.Item) error
+		DeleteOne(ctx context.Context, userID, skuID int64) error
+	}
+
+	lomsService interface {
+		InfoStocks(ctx context.Context, SKU int64) (int, error)
+	}
+
+	Handler struct {
+		productService productService
+		repo           repository
+		lomsService    lomsService
+	}
+)
+
+var (
+	ErrInvalidSKU         = errors.New("invalid sku")
+	ErrInsufficientStocks = errors.New("insufficient stocks")
+	ErrSetCartItem        = errors.New("failed to set cart item")
+	ErrDeleteCartItem     = errors.New("failed to delete cart item")
+)
+
+func New(repo repository, productService productService, lomsService lomsService) *Handler {
+	return &Handler{
+		repo:           repo,
+		productService: productService,
+		lomsService:    lomsService,
+	}
+}
+
+// SetItem replaces the count of the sku in the user cart, zero count removes the item from the cart.
+func (h *Handler) SetItem(ctx context.Context, userID int64, item domain.Item) error {
+	ctx, span := otel.Tracer("cart").Start(ctx, "service_set_item")
+	defer span.End()
+
+	if item.Count == 0 {
+		if err := h.repo.DeleteOne(ctx, userID, item.SKU); err != nil {
+			return fmt.Errorf("%w %w", ErrDeleteCartItem, err)
+		}
+
+		return nil
+	}
+
+	products, err := h.productService.GetProductInfo(ctx, uint32(item.SKU))
+	if err != nil {
+		return fmt.Errorf("%w %w", product.ErrGetProductInfo, err)
+	}
+
+	if products == nil {
+		return fmt.Errorf("ProductService.GetProductInfo return no product with given SKU=%d: %w", item.SKU, ErrInvalidSKU)
+	}
+
+	count, err := h.lomsService.InfoStocks(ctx, item.SKU)
+	if err != nil {
+		return fmt.Errorf("%w %w", loms.ErrGetStockInfo, err)
+	}
+
+	if count < int(item.Count) {
+		return fmt.Errorf("%w", ErrInsufficientStocks)
+	}
+
+	if err = h.repo.Set(ctx, userID, item); err != nil {
+		return fmt.Errorf("%w %w", ErrSetCartItem, err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/gojuno/minimock/v3"
+	"golang.org/x/time/rate"
 
 	"route256/cart/internal/domain"
 	"route256/cart/internal/service/cart/list/mock"
@@ -18,14 +19,24 @@ func BenchmarkGetItemsByUserID(b *testing.B) {
 	ctrl := minimock.NewController(b)
 	repMock := mock.NewRepositoryMock(ctrl)
 	productMock := mock.NewProductServiceMock(ctrl)
-	listHandler := New(repMock, productMock)
+	lomsMock := mock.NewLomsServiceMock(ctrl)
+	listHandler := New(repMock, productMock, lomsMock, rate.NewLimiter(rate.Inf, 1))
 
-	repMock.GetAllMock.Expect(ctx, userID).Return(getItems(), nil)
+	repMock.GetAllMock.ExpectUserIDParam2(userID).Return(getItems(), nil)
 
-	productMock.GetProductInfoMock.Return(&domain.Product{
-		Name:  "Книга",
-		Price: 444,
-	}, nil)
+	productMock.GetProductsInfoMock.Set(func(_ context.Context, skus []uint32) (map[uint32]*domain.Product, error) {
+		products := make(map[uint32]*domain.Product, len(skus))
+		for _, sku := range skus {
+			products[sku] = &domain.Product{
+				Name:  "Книга",
+				Price: 444,
+			}
+		}
+
+		return products, nil
+	})
+
+	lomsMock.InfoStocksMock.Return(10, nil)
 
 	b.ResetTimer()
 
@@ -47,15 +58,18 @@ func BenchmarkGetItemsByUserIDWithoutParallel(b *testing.B) {
 	ctrl := minimock.NewController(b)
 	repMock := mock.NewRepositoryMock(ctrl)
 	productMock := mock.NewProductServiceMock(ctrl)
-	listHandler := New(repMock, productMock)
+	lomsMock := mock.NewLomsServiceMock(ctrl)
+	listHandler := New(repMock, productMock, lomsMock, rate.NewLimiter(rate.Inf, 1))
 
-	repMock.GetAllMock.Expect(ctx, userID).Return(getItems(), nil)
+	repMock.GetAllMock.ExpectUserIDParam2(userID).Return(getItems(), nil)
 
 	productMock.GetProductInfoMock.Return(&domain.Product{
 		Name:  "Книга",
 		Price: 444,
 	}, nil)
 
+	lomsMock.InfoStocksMock.Return(10, nil)
+
 	b.ResetTimer()
 
 	b.Run("GetItemsByUserIDWithoutParallel", func(b *testing.B) {
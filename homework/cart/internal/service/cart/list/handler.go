@@ -5,39 +5,50 @@ import (
 	"errors"
 	"fmt"
 	"sort"
-	"sync"
 
 	"go.opentelemetry.io/otel"
-	"golang.org/x/time/rate"
 
 	"route256/cart/internal/app/errgroup"
+	"route256/cart/internal/clients/loms"
 	"route256/cart/internal/clients/product"
 	"route256/cart/internal/domain"
-	"route256/cart/internal/repository/memorycartrepo"
+	"route256/cart/pkg/logger"
 )
 
 type (
 	productService interface {
 		GetProductInfo(ctx context.Context, sku uint32) (*domain.Product, error)
+		GetProductsInfo(ctx context.Context, skus []uint32) (map[uint32]*domain.Product, error)
 	}
 	repository interface {
 		GetAll(ctx context.Context, userID int64) ([]domain.Item, error)
 	}
+	lomsService interface {
+		InfoStocks(ctx context.Context, SKU int64) (int, error)
+	}
+	limiter interface {
+		Wait(ctx context.Context) error
+	}
 
 	Handler struct {
 		productService productService
 		repo           repository
+		lomsService    lomsService
+		limiter        limiter
 	}
 )
 
-func New(repo repository, productService productService) *Handler {
+func New(repo repository, productService productService, lomsService lomsService, limiter limiter) *Handler {
 	return &Handler{
 		repo:           repo,
 		productService: productService,
+		lomsService:    lomsService,
+		limiter:        limiter,
 	}
 }
 
-const requestsPerSecond = 10
+// maxConcurrentStockRequests bounds parallel loms requests of a single cart listing
+const maxConcurrentStockRequests = 10
 
 func (h *Handler) GetItemsByUserID(ctx context.Context, userID int64) ([]domain.ListItem, error) {
 	ctx, span := otel.Tracer("cart").Start(ctx, "service_get_items_by_user_id")
@@ -46,8 +57,8 @@ func (h *Handler) GetItemsByUserID(ctx context.Context, userID int64) ([]domain.
 	// save to storage
 	cartItems, err := h.repo.GetAll(ctx, userID)
 	if err != nil {
-		if errors.Is(err, memorycartrepo.CartItemsNotFoundError{}) {
-			return nil, memorycartrepo.CartItemsNotFoundError{}
+		if errors.Is(err, domain.CartItemsNotFoundError{}) {
+			return nil, domain.CartItemsNotFoundError{}
 		}
 
 		return nil, fmt.Errorf("repository.GetCart: %w", err)
@@ -57,75 +68,65 @@ func (h *Handler) GetItemsByUserID(ctx context.Context, userID int64) ([]domain.
 		return cartItems[i].SKU < cartItems[j].SKU
 	})
 
-	eg, ctx := errgroup.WithContext(ctx)
-
-	limiter := rate.NewLimiter(requestsPerSecond, 1)
-
-	listItemsChannel := make(chan domain.ListItem, len(cartItems))
-	listItems := make([]domain.ListItem, len(cartItems))
-
-	wg := sync.WaitGroup{}
-	mx := sync.Mutex{}
+	skus := make([]uint32, len(cartItems))
+	for i, item := range cartItems {
+		skus[i] = uint32(item.SKU)
+	}
 
-	wg.Add(1)
+	// Все товары корзины запрашиваем одним батчем, вместо N запросов под rate limiter
+	products, err := h.productService.GetProductsInfo(ctx, skus)
+	if err != nil {
+		return nil, fmt.Errorf("%w %w", product.ErrGetProductInfo, err)
+	}
 
-	go func() {
-		defer wg.Done()
+	// Проверяем все товары до запуска горутин, чтобы не бросать уже запущенные запросы
+	for _, item := range cartItems {
+		if products[uint32(item.SKU)] == nil {
+			return nil, fmt.Errorf("%w %w: SKU=%d", product.ErrGetProductInfo, product.ErrProductNotFound, item.SKU)
+		}
+	}
 
-		idx := 0
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(maxConcurrentStockRequests)
 
-		for {
-			select {
-			case listItem, ok := <-listItemsChannel:
-				if ok {
-					mx.Lock()
-					listItems[idx] = listItem
-					mx.Unlock()
+	listItems := make([]domain.ListItem, len(cartItems))
 
-					idx++
-				} else {
-					return
-				}
-			case <-ctx.Done():
-				return
-			}
-		}
-	}()
+	for i, item := range cartItems {
+		productResponse := products[uint32(item.SKU)]
 
-	for _, item := range cartItems {
 		eg.Go(func() error {
-			if err = limiter.Wait(ctx); err != nil {
+			if err := h.limiter.Wait(ctx); err != nil {
 				return fmt.Errorf("rate limiter error: %w", err)
 			}
 
-			productResponse, errGetProductInfo := h.productService.GetProductInfo(ctx, uint32(item.SKU))
-
-			if errGetProductInfo != nil {
-				return fmt.Errorf("%w %w", product.ErrGetProductInfo, err)
-			}
-
-			select {
-			case listItemsChannel <- domain.ListItem{
+			// Каждая горутина пишет только в свой элемент, порядок по SKU сохраняется
+			listItems[i] = domain.ListItem{
 				SKU:   item.SKU,
 				Count: item.Count,
 				Name:  productResponse.Name,
 				Price: productResponse.Price,
-			}:
-			case <-ctx.Done():
-				return ctx.Err()
 			}
 
+			availableCount, errInfoStocks := h.lomsService.InfoStocks(ctx, item.SKU)
+			if errInfoStocks != nil {
+				// Сбой loms не должен ломать просмотр корзины, наличие товара помечаем неизвестным
+				logger.Errorw(ctx, loms.ErrGetStockInfo.Error(), "sku", item.SKU, "error", errInfoStocks)
+				listItems[i].Availability = domain.AvailabilityUnknown
+
+				return nil
+			}
+
+			listItems[i].AvailableCount = availableCount
+			listItems[i].Availability = domain.NewAvailability(item.Count, availableCount)
+
 			return nil
 		})
 	}
 
 	if err = eg.Wait(); err != nil {
-		close(listItemsChannel)
 		return nil, fmt.Errorf("%w", err)
 	}
 
-	wg.Wait()
-
 	return listItems, nil
 }
 
@@ -133,8 +134,8 @@ func (h *Handler) GetItemsByUserIDWithoutParallel(ctx context.Context, userID in
 	// save to storage
 	cartItems, err := h.repo.GetAll(ctx, userID)
 	if err != nil {
-		if errors.Is(err, memorycartrepo.CartItemsNotFoundError{}) {
-			return nil, memorycartrepo.CartItemsNotFoundError{}
+		if errors.Is(err, domain.CartItemsNotFoundError{}) {
+			return nil, domain.CartItemsNotFoundError{}
 		}
 
 		return nil, fmt.Errorf("repository.GetCart: %w", err)
@@ -152,11 +153,18 @@ func (h *Handler) GetItemsByUserIDWithoutParallel(ctx context.Context, userID in
 			return nil, fmt.Errorf("%w %w", product.ErrGetProductInfo, err)
 		}
 
+		availableCount, err := h.lomsService.InfoStocks(ctx, item.SKU)
+		if err != nil {
+			return nil, fmt.Errorf("%w %w", loms.ErrGetStockInfo, err)
+		}
+
 		listItems[i] = domain.ListItem{
-			SKU:   item.SKU,
-			Count: item.Count,
-			Name:  productResponse.Name,
-			Price: productResponse.Price,
+			SKU:            item.SKU,
+			Count:          item.Count,
+			Name:           productResponse.Name,
+			Price:          productResponse.Price,
+			AvailableCount: availableCount,
+			Availability:   domain.NewAvailability(item.Count, availableCount),
 		}
 	}
 
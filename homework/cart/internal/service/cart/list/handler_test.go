@@ -8,10 +8,10 @@ import (
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
+	"golang.org/x/time/rate"
 
 	"route256/cart/internal/clients/product"
 	"route256/cart/internal/domain"
-	"route256/cart/internal/repository/memorycartrepo"
 	"route256/cart/internal/service/cart/list/mock"
 )
 
@@ -27,6 +27,7 @@ func TestGetItemsByUserIDWithRepoErrorsWithPrepare(t *testing.T) {
 	type fields struct {
 		repMock     *mock.RepositoryMock
 		productMock *mock.ProductServiceMock
+		lomsMock    *mock.LomsServiceMock
 	}
 
 	type data struct {
@@ -40,9 +41,9 @@ func TestGetItemsByUserIDWithRepoErrorsWithPrepare(t *testing.T) {
 		name:   "Repo returns CartItemsNotFoundError",
 		userID: 123,
 		prepare: func(f *fields) {
-			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(nil, memorycartrepo.CartItemsNotFoundError{})
+			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(nil, domain.CartItemsNotFoundError{})
 		},
-		wantErr: memorycartrepo.CartItemsNotFoundError{},
+		wantErr: domain.CartItemsNotFoundError{},
 	}, {
 		name:   "Repo returns an error different from CartItemsNotFoundError",
 		userID: 123,
@@ -62,9 +63,10 @@ func TestGetItemsByUserIDWithRepoErrorsWithPrepare(t *testing.T) {
 			fieldsForTableTest := fields{
 				repMock:     mock.NewRepositoryMock(ctrl),
 				productMock: mock.NewProductServiceMock(ctrl),
+				lomsMock:    mock.NewLomsServiceMock(ctrl),
 			}
 
-			getHandler := New(fieldsForTableTest.repMock, fieldsForTableTest.productMock)
+			getHandler := New(fieldsForTableTest.repMock, fieldsForTableTest.productMock, fieldsForTableTest.lomsMock, rate.NewLimiter(rate.Inf, 1))
 
 			tt.prepare(&fieldsForTableTest)
 
@@ -82,12 +84,14 @@ func TestGetItemsByUserIDWithPrepare(t *testing.T) {
 	type fields struct {
 		productMock *mock.ProductServiceMock
 		repMock     *mock.RepositoryMock
+		lomsMock    *mock.LomsServiceMock
 	}
 
 	type data struct {
 		name    string
 		userID  int64
 		prepare func(f *fields)
+		want    []domain.ListItem
 		wantErr error
 	}
 
@@ -96,7 +100,7 @@ func TestGetItemsByUserIDWithPrepare(t *testing.T) {
 			name:   "Product service error",
 			userID: 123,
 			prepare: func(f *fields) {
-				f.productMock.GetProductInfoMock.ExpectSkuParam2(uint32(234)).Return(nil, product.ErrGetProductInfo)
+				f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{234}).Return(nil, product.ErrGetProductInfo)
 				f.repMock.GetAllMock.ExpectUserIDParam2(123).Return([]domain.Item{
 					{
 						SKU:   234,
@@ -106,13 +110,112 @@ func TestGetItemsByUserIDWithPrepare(t *testing.T) {
 			},
 			wantErr: product.ErrGetProductInfo,
 		},
+		{
+			name:   "Product service does not know SKU",
+			userID: 123,
+			prepare: func(f *fields) {
+				f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{234}).Return(map[uint32]*domain.Product{}, nil)
+				f.repMock.GetAllMock.ExpectUserIDParam2(123).Return([]domain.Item{
+					{
+						SKU:   234,
+						Count: 7,
+					},
+				}, nil)
+			},
+			wantErr: product.ErrGetProductInfo,
+		},
+		{
+			name:   "Unknown SKU after a known one does not start stock requests",
+			userID: 123,
+			prepare: func(f *fields) {
+				f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{234, 235}).Return(map[uint32]*domain.Product{
+					234: {
+						Name:  "Книга",
+						Price: 400,
+					},
+				}, nil)
+				f.repMock.GetAllMock.ExpectUserIDParam2(123).Return([]domain.Item{
+					{
+						SKU:   235,
+						Count: 1,
+					},
+					{
+						SKU:   234,
+						Count: 7,
+					},
+				}, nil)
+			},
+			wantErr: product.ErrProductNotFound,
+		},
 		{
 			name:   "Success",
 			userID: 525,
 			prepare: func(f *fields) {
-				f.productMock.GetProductInfoMock.ExpectSkuParam2(uint32(983)).Return(&domain.Product{
-					Name:  "Книга",
-					Price: 400,
+				f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{983}).Return(map[uint32]*domain.Product{
+					983: {
+						Name:  "Книга",
+						Price: 400,
+					},
+				}, nil)
+				f.repMock.GetAllMock.ExpectUserIDParam2(525).Return([]domain.Item{
+					{
+						SKU:   983,
+						Count: 2,
+					},
+				}, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(983).Return(5, nil)
+			},
+			want: []domain.ListItem{
+				{
+					SKU:            983,
+					Count:          2,
+					Name:           "Книга",
+					Price:          400,
+					AvailableCount: 5,
+					Availability:   domain.AvailabilityAvailable,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "Insufficient stock is annotated",
+			userID: 525,
+			prepare: func(f *fields) {
+				f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{983}).Return(map[uint32]*domain.Product{
+					983: {
+						Name:  "Книга",
+						Price: 400,
+					},
+				}, nil)
+				f.repMock.GetAllMock.ExpectUserIDParam2(525).Return([]domain.Item{
+					{
+						SKU:   983,
+						Count: 2,
+					},
+				}, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(983).Return(1, nil)
+			},
+			want: []domain.ListItem{
+				{
+					SKU:            983,
+					Count:          2,
+					Name:           "Книга",
+					Price:          400,
+					AvailableCount: 1,
+					Availability:   domain.AvailabilityInsufficient,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "Loms service error marks availability unknown",
+			userID: 525,
+			prepare: func(f *fields) {
+				f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{983}).Return(map[uint32]*domain.Product{
+					983: {
+						Name:  "Книга",
+						Price: 400,
+					},
 				}, nil)
 				f.repMock.GetAllMock.ExpectUserIDParam2(525).Return([]domain.Item{
 					{
@@ -120,6 +223,16 @@ func TestGetItemsByUserIDWithPrepare(t *testing.T) {
 						Count: 2,
 					},
 				}, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(983).Return(0, fmt.Errorf("test error"))
+			},
+			want: []domain.ListItem{
+				{
+					SKU:          983,
+					Count:        2,
+					Name:         "Книга",
+					Price:        400,
+					Availability: domain.AvailabilityUnknown,
+				},
 			},
 			wantErr: nil,
 		},
@@ -134,13 +247,15 @@ func TestGetItemsByUserIDWithPrepare(t *testing.T) {
 			fieldsForTableTest := fields{
 				productMock: mock.NewProductServiceMock(ctrl),
 				repMock:     mock.NewRepositoryMock(ctrl),
+				lomsMock:    mock.NewLomsServiceMock(ctrl),
 			}
 
-			getHandler := New(fieldsForTableTest.repMock, fieldsForTableTest.productMock)
+			getHandler := New(fieldsForTableTest.repMock, fieldsForTableTest.productMock, fieldsForTableTest.lomsMock, rate.NewLimiter(rate.Inf, 1))
 
 			tt.prepare(&fieldsForTableTest)
-			_, err := getHandler.GetItemsByUserID(ctx, tt.userID)
+			got, err := getHandler.GetItemsByUserID(ctx, tt.userID)
 			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
 		})
 	}
 }
@@ -24,6 +24,12 @@ type ProductServiceMock struct {
 	afterGetProductInfoCounter  uint64
 	beforeGetProductInfoCounter uint64
 	GetProductInfoMock          mProductServiceMockGetProductInfo
+
+	funcGetProductsInfo          func(ctx context.Context, skus []uint32) (m1 map[uint32]*domain.Product, err error)
+	inspectFuncGetProductsInfo   func(ctx context.Context, skus []uint32)
+	afterGetProductsInfoCounter  uint64
+	beforeGetProductsInfoCounter uint64
+	GetProductsInfoMock          mProductServiceMockGetProductsInfo
 }
 
 // NewProductServiceMock returns a mock for list.productService
@@ -37,6 +43,9 @@ func NewProductServiceMock(t minimock.Tester) *ProductServiceMock {
 	m.GetProductInfoMock = mProductServiceMockGetProductInfo{mock: m}
 	m.GetProductInfoMock.callArgs = []*ProductServiceMockGetProductInfoParams{}
 
+	m.GetProductsInfoMock = mProductServiceMockGetProductsInfo{mock: m}
+	m.GetProductsInfoMock.callArgs = []*ProductServiceMockGetProductsInfoParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -363,11 +372,334 @@ func (m *ProductServiceMock) MinimockGetProductInfoInspect() {
 	}
 }
 
+type mProductServiceMockGetProductsInfo struct {
+	optional           bool
+	mock               *ProductServiceMock
+	defaultExpectation *ProductServiceMockGetProductsInfoExpectation
+	expectations       []*ProductServiceMockGetProductsInfoExpectation
+
+	callArgs []*ProductServiceMockGetProductsInfoParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// ProductServiceMockGetProductsInfoExpectation specifies expectation struct of the productService.GetProductsInfo
+type ProductServiceMockGetProductsInfoExpectation struct {
+	mock      *ProductServiceMock
+	params    *ProductServiceMockGetProductsInfoParams
+	paramPtrs *ProductServiceMockGetProductsInfoParamPtrs
+	results   *ProductServiceMockGetProductsInfoResults
+	Counter   uint64
+}
+
+// ProductServiceMockGetProductsInfoParams contains parameters of the productService.GetProductsInfo
+type ProductServiceMockGetProductsInfoParams struct {
+	ctx  context.Context
+	skus []uint32
+}
+
+// ProductServiceMockGetProductsInfoParamPtrs contains pointers to parameters of the productService.GetProductsInfo
+type ProductServiceMockGetProductsInfoParamPtrs struct {
+	ctx  *context.Context
+	skus *[]uint32
+}
+
+// ProductServiceMockGetProductsInfoResults contains results of the productService.GetProductsInfo
+type ProductServiceMockGetProductsInfoResults struct {
+	m1  map[uint32]*domain.Product
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option by default unless you really need it, as it helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetProductsInfo *mProductServiceMockGetProductsInfo) Optional() *mProductServiceMockGetProductsInfo {
+	mmGetProductsInfo.optional = true
+	return mmGetProductsInfo
+}
+
+// Expect sets up expected params for productService.GetProductsInfo
+func (mmGetProductsInfo *mProductServiceMockGetProductsInfo) Expect(ctx context.Context, skus []uint32) *mProductServiceMockGetProductsInfo {
+	if mmGetProductsInfo.mock.funcGetProductsInfo != nil {
+		mmGetProductsInfo.mock.t.Fatalf("ProductServiceMock.GetProductsInfo mock is already set by Set")
+	}
+
+	if mmGetProductsInfo.defaultExpectation == nil {
+		mmGetProductsInfo.defaultExpectation = &ProductServiceMockGetProductsInfoExpectation{}
+	}
+
+	if mmGetProductsInfo.defaultExpectation.paramPtrs != nil {
+		mmGetProductsInfo.mock.t.Fatalf("ProductServiceMock.GetProductsInfo mock is already set by ExpectParams functions")
+	}
+
+	mmGetProductsInfo.defaultExpectation.params = &ProductServiceMockGetProductsInfoParams{ctx, skus}
+	for _, e := range mmGetProductsInfo.expectations {
+		if minimock.Equal(e.params, mmGetProductsInfo.defaultExpectation.params) {
+			mmGetProductsInfo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetProductsInfo.defaultExpectation.params)
+		}
+	}
+
+	return mmGetProductsInfo
+}
+
+// ExpectCtxParam1 sets up expected param ctx for productService.GetProductsInfo
+func (mmGetProductsInfo *mProductServiceMockGetProductsInfo) ExpectCtxParam1(ctx context.Context) *mProductServiceMockGetProductsInfo {
+	if mmGetProductsInfo.mock.funcGetProductsInfo != nil {
+		mmGetProductsInfo.mock.t.Fatalf("ProductServiceMock.GetProductsInfo mock is already set by Set")
+	}
+
+	if mmGetProductsInfo.defaultExpectation == nil {
+		mmGetProductsInfo.defaultExpectation = &ProductServiceMockGetProductsInfoExpectation{}
+	}
+
+	if mmGetProductsInfo.defaultExpectation.params != nil {
+		mmGetProductsInfo.mock.t.Fatalf("ProductServiceMock.GetProductsInfo mock is already set by Expect")
+	}
+
+	if mmGetProductsInfo.defaultExpectation.paramPtrs == nil {
+		mmGetProductsInfo.defaultExpectation.paramPtrs = &ProductServiceMockGetProductsInfoParamPtrs{}
+	}
+	mmGetProductsInfo.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetProductsInfo
+}
+
+// ExpectSkusParam2 sets up expected param skus for productService.GetProductsInfo
+func (mmGetProductsInfo *mProductServiceMockGetProductsInfo) ExpectSkusParam2(skus []uint32) *mProductServiceMockGetProductsInfo {
+	if mmGetProductsInfo.mock.funcGetProductsInfo != nil {
+		mmGetProductsInfo.mock.t.Fatalf("ProductServiceMock.GetProductsInfo mock is already set by Set")
+	}
+
+	if mmGetProductsInfo.defaultExpectation == nil {
+		mmGetProductsInfo.defaultExpectation = &ProductServiceMockGetProductsInfoExpectation{}
+	}
+
+	if mmGetProductsInfo.defaultExpectation.params != nil {
+		mmGetProductsInfo.mock.t.Fatalf("ProductServiceMock.GetProductsInfo mock is already set by Expect")
+	}
+
+	if mmGetProductsInfo.defaultExpectation.paramPtrs == nil {
+		mmGetProductsInfo.defaultExpectation.paramPtrs = &ProductServiceMockGetProductsInfoParamPtrs{}
+	}
+	mmGetProductsInfo.defaultExpectation.paramPtrs.skus = &skus
+
+	return mmGetProductsInfo
+}
+
+// Inspect accepts an inspector function that has same arguments as the productService.GetProductsInfo
+func (mmGetProductsInfo *mProductServiceMockGetProductsInfo) Inspect(f func(ctx context.Context, skus []uint32)) *mProductServiceMockGetProductsInfo {
+	if mmGetProductsInfo.mock.inspectFuncGetProductsInfo != nil {
+		mmGetProductsInfo.mock.t.Fatalf("Inspect function is already set for ProductServiceMock.GetProductsInfo")
+	}
+
+	mmGetProductsInfo.mock.inspectFuncGetProductsInfo = f
+
+	return mmGetProductsInfo
+}
+
+// Return sets up results that will be returned by productService.GetProductsInfo
+func (mmGetProductsInfo *mProductServiceMockGetProductsInfo) Return(m1 map[uint32]*domain.Product, err error) *ProductServiceMock {
+	if mmGetProductsInfo.mock.funcGetProductsInfo != nil {
+		mmGetProductsInfo.mock.t.Fatalf("ProductServiceMock.GetProductsInfo mock is already set by Set")
+	}
+
+	if mmGetProductsInfo.defaultExpectation == nil {
+		mmGetProductsInfo.defaultExpectation = &ProductServiceMockGetProductsInfoExpectation{mock: mmGetProductsInfo.mock}
+	}
+	mmGetProductsInfo.defaultExpectation.results = &ProductServiceMockGetProductsInfoResults{m1, err}
+	return mmGetProductsInfo.mock
+}
+
+// Set uses given function f to mock the productService.GetProductsInfo method
+func (mmGetProductsInfo *mProductServiceMockGetProductsInfo) Set(f func(ctx context.Context, skus []uint32) (m1 map[uint32]*domain.Product, err error)) *ProductServiceMock {
+	if mmGetProductsInfo.defaultExpectation != nil {
+		mmGetProductsInfo.mock.t.Fatalf("Default expectation is already set for the productService.GetProductsInfo method")
+	}
+
+	if len(mmGetProductsInfo.expectations) > 0 {
+		mmGetProductsInfo.mock.t.Fatalf("Some expectations are already set for the productService.GetProductsInfo method")
+	}
+
+	mmGetProductsInfo.mock.funcGetProductsInfo = f
+	return mmGetProductsInfo.mock
+}
+
+// When sets expectation for the productService.GetProductsInfo which will trigger the result defined by the following
+// Then helper
+func (mmGetProductsInfo *mProductServiceMockGetProductsInfo) When(ctx context.Context, skus []uint32) *ProductServiceMockGetProductsInfoExpectation {
+	if mmGetProductsInfo.mock.funcGetProductsInfo != nil {
+		mmGetProductsInfo.mock.t.Fatalf("ProductServiceMock.GetProductsInfo mock is already set by Set")
+	}
+
+	expectation := &ProductServiceMockGetProductsInfoExpectation{
+		mock:   mmGetProductsInfo.mock,
+		params: &ProductServiceMockGetProductsInfoParams{ctx, skus},
+	}
+	mmGetProductsInfo.expectations = append(mmGetProductsInfo.expectations, expectation)
+	return expectation
+}
+
+// Then sets up productService.GetProductsInfo return parameters for the expectation previously defined by the When method
+func (e *ProductServiceMockGetProductsInfoExpectation) Then(m1 map[uint32]*domain.Product, err error) *ProductServiceMock {
+	e.results = &ProductServiceMockGetProductsInfoResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times productService.GetProductsInfo should be invoked
+func (mmGetProductsInfo *mProductServiceMockGetProductsInfo) Times(n uint64) *mProductServiceMockGetProductsInfo {
+	if n == 0 {
+		mmGetProductsInfo.mock.t.Fatalf("Times of ProductServiceMock.GetProductsInfo mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetProductsInfo.expectedInvocations, n)
+	return mmGetProductsInfo
+}
+
+func (mmGetProductsInfo *mProductServiceMockGetProductsInfo) invocationsDone() bool {
+	if len(mmGetProductsInfo.expectations) == 0 && mmGetProductsInfo.defaultExpectation == nil && mmGetProductsInfo.mock.funcGetProductsInfo == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetProductsInfo.mock.afterGetProductsInfoCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetProductsInfo.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetProductsInfo implements list.productService
+func (mmGetProductsInfo *ProductServiceMock) GetProductsInfo(ctx context.Context, skus []uint32) (m1 map[uint32]*domain.Product, err error) {
+	mm_atomic.AddUint64(&mmGetProductsInfo.beforeGetProductsInfoCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetProductsInfo.afterGetProductsInfoCounter, 1)
+
+	if mmGetProductsInfo.inspectFuncGetProductsInfo != nil {
+		mmGetProductsInfo.inspectFuncGetProductsInfo(ctx, skus)
+	}
+
+	mm_params := ProductServiceMockGetProductsInfoParams{ctx, skus}
+
+	// Record call args
+	mmGetProductsInfo.GetProductsInfoMock.mutex.Lock()
+	mmGetProductsInfo.GetProductsInfoMock.callArgs = append(mmGetProductsInfo.GetProductsInfoMock.callArgs, &mm_params)
+	mmGetProductsInfo.GetProductsInfoMock.mutex.Unlock()
+
+	for _, e := range mmGetProductsInfo.GetProductsInfoMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetProductsInfo.GetProductsInfoMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetProductsInfo.GetProductsInfoMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetProductsInfo.GetProductsInfoMock.defaultExpectation.params
+		mm_want_ptrs := mmGetProductsInfo.GetProductsInfoMock.defaultExpectation.paramPtrs
+
+		mm_got := ProductServiceMockGetProductsInfoParams{ctx, skus}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetProductsInfo.t.Errorf("ProductServiceMock.GetProductsInfo got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skus != nil && !minimock.Equal(*mm_want_ptrs.skus, mm_got.skus) {
+				mmGetProductsInfo.t.Errorf("ProductServiceMock.GetProductsInfo got unexpected parameter skus, want: %#v, got: %#v%s\n", *mm_want_ptrs.skus, mm_got.skus, minimock.Diff(*mm_want_ptrs.skus, mm_got.skus))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetProductsInfo.t.Errorf("ProductServiceMock.GetProductsInfo got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetProductsInfo.GetProductsInfoMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetProductsInfo.t.Fatal("No results are set for the ProductServiceMock.GetProductsInfo")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetProductsInfo.funcGetProductsInfo != nil {
+		return mmGetProductsInfo.funcGetProductsInfo(ctx, skus)
+	}
+	mmGetProductsInfo.t.Fatalf("Unexpected call to ProductServiceMock.GetProductsInfo. %v %v", ctx, skus)
+	return
+}
+
+// GetProductsInfoAfterCounter returns a count of finished ProductServiceMock.GetProductsInfo invocations
+func (mmGetProductsInfo *ProductServiceMock) GetProductsInfoAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetProductsInfo.afterGetProductsInfoCounter)
+}
+
+// GetProductsInfoBeforeCounter returns a count of ProductServiceMock.GetProductsInfo invocations
+func (mmGetProductsInfo *ProductServiceMock) GetProductsInfoBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetProductsInfo.beforeGetProductsInfoCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProductServiceMock.GetProductsInfo.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetProductsInfo *mProductServiceMockGetProductsInfo) Calls() []*ProductServiceMockGetProductsInfoParams {
+	mmGetProductsInfo.mutex.RLock()
+
+	argCopy := make([]*ProductServiceMockGetProductsInfoParams, len(mmGetProductsInfo.callArgs))
+	copy(argCopy, mmGetProductsInfo.callArgs)
+
+	mmGetProductsInfo.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetProductsInfoDone returns true if the count of the GetProductsInfo invocations corresponds
+// the number of defined expectations
+func (m *ProductServiceMock) MinimockGetProductsInfoDone() bool {
+	if m.GetProductsInfoMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetProductsInfoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetProductsInfoMock.invocationsDone()
+}
+
+// MinimockGetProductsInfoInspect logs each unmet expectation
+func (m *ProductServiceMock) MinimockGetProductsInfoInspect() {
+	for _, e := range m.GetProductsInfoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProductServiceMock.GetProductsInfo with params: %#v", *e.params)
+		}
+	}
+
+	afterGetProductsInfoCounter := mm_atomic.LoadUint64(&m.afterGetProductsInfoCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetProductsInfoMock.defaultExpectation != nil && afterGetProductsInfoCounter < 1 {
+		if m.GetProductsInfoMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ProductServiceMock.GetProductsInfo")
+		} else {
+			m.t.Errorf("Expected call to ProductServiceMock.GetProductsInfo with params: %#v", *m.GetProductsInfoMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetProductsInfo != nil && afterGetProductsInfoCounter < 1 {
+		m.t.Error("Expected call to ProductServiceMock.GetProductsInfo")
+	}
+
+	if !m.GetProductsInfoMock.invocationsDone() && afterGetProductsInfoCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProductServiceMock.GetProductsInfo but found %d calls",
+			mm_atomic.LoadUint64(&m.GetProductsInfoMock.expectedInvocations), afterGetProductsInfoCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *ProductServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockGetProductInfoInspect()
+
+			m.MinimockGetProductsInfoInspect()
 			m.t.FailNow()
 		}
 	})
@@ -392,5 +724,6 @@ func (m *ProductServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ProductServiceMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockGetProductInfoDone()
+		m.MinimockGetProductInfoDone() &&
+		m.MinimockGetProductsInfoDone()
 }
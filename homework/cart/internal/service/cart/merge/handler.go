@@ -0,0 +1,200 @@
+package merge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"go.opentelemetry.io/otel"
+
+	"route256/cart/internal/app/errgroup"
+	"route256/cart/internal/clients/loms"
+	"route256/cart/internal/clients/product"
+	"route256/cart/internal/domain"
+)
+
+type (
+	repository interface {
+		GetAll(ctx context.Context, userID int64) ([]domain.Item, error)
+		Add(ctx context.Context, userID int64, item domain.Item) error
+		DeleteAll(ctx context.Context, userID int64) error
+	}
+
+	lomsService interface {
+		InfoStocks(ctx context.Context, SKU int64) (int, error)
+	}
+
+	productService interface {
+		GetProductsInfo(ctx context.Context, skus []uint32) (map[uint32]*domain.Product, error)
+	}
+
+	limiter interface {
+		Wait(ctx context.Context) error
+	}
+
+	txManager interface {
+		RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	Handler struct {
+		repo           repository
+		lomsService    lomsService
+		productService productService
+		limiter        limiter
+		txManager      txManager
+	}
+)
+
+var (
+	ErrInsufficientStocks = errors.New("insufficient stocks")
+	ErrCountOverflow      = errors.New("merged item count exceeds the maximum")
+	ErrAddCartItem        = errors.New("failed to add cart item")
+	ErrDeleteCartItems    = errors.New("failed to delete cart items")
+)
+
+func New(repo repository, lomsService lomsService, productService productService, limiter limiter, txManager txManager) *Handler {
+	return &Handler{
+		repo:           repo,
+		lomsService:    lomsService,
+		productService: productService,
+		limiter:        limiter,
+		txManager:      txManager,
+	}
+}
+
+// MergeCarts moves items of the fromUserID cart into the toUserID cart and returns the merged cart.
+// If the stock of any sku is not enough for the merged count or the merged count does not fit the item count,
+// neither of the carts is changed. The merged cart is built before the writes, so a merge that succeeded
+// is never reported as failed.
+func (h *Handler) MergeCarts(ctx context.Context, fromUserID, toUserID int64) ([]domain.ListItem, error) {
+	ctx, span := otel.Tracer("cart").Start(ctx, "service_merge_carts")
+	defer span.End()
+
+	fromItems, err := h.repo.GetAll(ctx, fromUserID)
+	if err != nil {
+		if errors.Is(err, domain.CartItemsNotFoundError{}) {
+			return nil, domain.CartItemsNotFoundError{}
+		}
+
+		return nil, fmt.Errorf("repository.GetCart: %w", err)
+	}
+
+	toItems, err := h.repo.GetAll(ctx, toUserID)
+	if err != nil && !errors.Is(err, domain.CartItemsNotFoundError{}) {
+		return nil, fmt.Errorf("repository.GetCart: %w", err)
+	}
+
+	mergedItems, err := mergeItems(toItems, fromItems)
+	if err != nil {
+		return nil, err
+	}
+
+	skus := make([]uint32, len(mergedItems))
+	for i, item := range mergedItems {
+		skus[i] = uint32(item.SKU)
+	}
+
+	products, err := h.productService.GetProductsInfo(ctx, skus)
+	if err != nil {
+		return nil, fmt.Errorf("%w %w", product.ErrGetProductInfo, err)
+	}
+
+	for _, item := range mergedItems {
+		if products[uint32(item.SKU)] == nil {
+			return nil, fmt.Errorf("%w %w: SKU=%d", product.ErrGetProductInfo, product.ErrProductNotFound, item.SKU)
+		}
+	}
+
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	listItems := make([]domain.ListItem, len(mergedItems))
+
+	for i, item := range mergedItems {
+		eg.Go(func() error {
+			if err := h.limiter.Wait(egCtx); err != nil {
+				return fmt.Errorf("rate limiter error: %w", err)
+			}
+
+			count, err := h.lomsService.InfoStocks(egCtx, item.SKU)
+			if err != nil {
+				return fmt.Errorf("%w %w", loms.ErrGetStockInfo, err)
+			}
+
+			if count < int(item.Count) {
+				return fmt.Errorf("sku %d: %w", item.SKU, ErrInsufficientStocks)
+			}
+
+			productInfo := products[uint32(item.SKU)]
+			listItems[i] = domain.ListItem{
+				SKU:            item.SKU,
+				Count:          item.Count,
+				Name:           productInfo.Name,
+				Price:          productInfo.Price,
+				AvailableCount: count,
+				Availability:   domain.NewAvailability(item.Count, count),
+			}
+
+			return nil
+		})
+	}
+
+	if err = eg.Wait(); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	// Переносим товары приращениями в одной транзакции: добавленное в целевую корзину после чтения не затирается,
+	// а сбой посередине не оставляет наполовину объединённую корзину рядом с исходной
+	err = h.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		for _, item := range fromItems {
+			if err := h.repo.Add(ctx, toUserID, item); err != nil {
+				return fmt.Errorf("sku %d: %w %w", item.SKU, ErrAddCartItem, err)
+			}
+		}
+
+		if err := h.repo.DeleteAll(ctx, fromUserID); err != nil {
+			return fmt.Errorf("%w %w", ErrDeleteCartItems, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// После записи корзина уже объединена, поэтому ответ собран заранее и повторное чтение не нужно
+	return listItems, nil
+}
+
+// mergeItems sums counts of the same skus of both carts, the result is sorted by sku.
+// A sum above math.MaxUint16 does not fit the item count and fails the merge.
+func mergeItems(toItems, fromItems []domain.Item) ([]domain.Item, error) {
+	counts := make(map[int64]int, len(toItems)+len(fromItems))
+
+	for _, item := range toItems {
+		counts[item.SKU] += int(item.Count)
+	}
+
+	for _, item := range fromItems {
+		counts[item.SKU] += int(item.Count)
+	}
+
+	mergedItems := make([]domain.Item, 0, len(counts))
+	for sku, count := range counts {
+		if count > math.MaxUint16 {
+			return nil, fmt.Errorf("sku %d: %w: %d", sku, ErrCountOverflow, count)
+		}
+
+		mergedItems = append(mergedItems, domain.Item{
+			SKU:   sku,
+			Count: uint16(count),
+		})
+	}
+
+	sort.Slice(mergedItems, func(i, j int) bool {
+		return mergedItems[i].SKU < mergedItems[j].SKU
+	})
+
+	return mergedItems, nil
+}
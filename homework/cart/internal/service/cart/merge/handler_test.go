@@ -0,0 +1,201 @@
+package merge
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+	"golang.org/x/time/rate"
+
+	"route256/cart/internal/clients/loms"
+	"route256/cart/internal/clients/product"
+	"route256/cart/internal/domain"
+	"route256/cart/internal/service/cart/merge/mock"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestMergeCartsTableWithPrepare(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const (
+		fromUserID = 1
+		toUserID   = 2
+	)
+
+	type (
+		fields struct {
+			repMock     *mock.RepositoryMock
+			lomsMock    *mock.LomsServiceMock
+			productMock *mock.ProductServiceMock
+			addedItems  map[int64][]domain.Item
+			txMock      *mock.TxManagerMock
+		}
+
+		data struct {
+			name      string
+			prepare   func(f *fields)
+			wantAdded []domain.Item
+			want      []domain.ListItem
+			wantErr   error
+		}
+	)
+
+	products := map[uint32]*domain.Product{
+		100: {Name: "Книга", Price: 300},
+		200: {Name: "Ручка", Price: 50},
+	}
+
+	runInTx := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	mergedList := []domain.ListItem{
+		{SKU: 100, Count: 3, Name: "Книга", Price: 300, AvailableCount: 10, Availability: domain.AvailabilityAvailable},
+		{SKU: 200, Count: 1, Name: "Ручка", Price: 50, AvailableCount: 10, Availability: domain.AvailabilityAvailable},
+	}
+
+	testData := []data{
+		{
+			name: "source cart not found",
+			prepare: func(f *fields) {
+				f.repMock.GetAllMock.When(minimock.AnyContext, fromUserID).Then(nil, domain.CartItemsNotFoundError{})
+			},
+			wantErr: domain.CartItemsNotFoundError{},
+		},
+		{
+			name: "merge into empty cart",
+			prepare: func(f *fields) {
+				f.repMock.GetAllMock.When(minimock.AnyContext, fromUserID).Then([]domain.Item{{SKU: 100, Count: 3}, {SKU: 200, Count: 1}}, nil)
+				f.repMock.GetAllMock.When(minimock.AnyContext, toUserID).Then(nil, domain.CartItemsNotFoundError{})
+				f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{100, 200}).Return(products, nil)
+				f.lomsMock.InfoStocksMock.Set(func(_ context.Context, _ int64) (int, error) {
+					return 10, nil
+				})
+				f.txMock.RunInTxMock.Set(runInTx)
+				f.repMock.AddMock.Times(2).Return(nil)
+				f.repMock.DeleteAllMock.ExpectUserIDParam2(fromUserID).Return(nil)
+			},
+			want: mergedList,
+		},
+		{
+			name: "counts of the same sku are summed",
+			prepare: func(f *fields) {
+				f.repMock.GetAllMock.When(minimock.AnyContext, fromUserID).Then([]domain.Item{{SKU: 100, Count: 2}}, nil)
+				f.repMock.GetAllMock.When(minimock.AnyContext, toUserID).Then([]domain.Item{{SKU: 100, Count: 1}, {SKU: 200, Count: 1}}, nil)
+				f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{100, 200}).Return(products, nil)
+				f.lomsMock.InfoStocksMock.Set(func(_ context.Context, _ int64) (int, error) {
+					return 10, nil
+				})
+				f.txMock.RunInTxMock.Set(runInTx)
+				f.repMock.AddMock.Set(func(_ context.Context, userID int64, item domain.Item) error {
+					f.addedItems[userID] = append(f.addedItems[userID], item)
+
+					return nil
+				})
+				f.repMock.DeleteAllMock.ExpectUserIDParam2(fromUserID).Return(nil)
+			},
+			// В целевую корзину пишется только приращение из исходной
+			wantAdded: []domain.Item{{SKU: 100, Count: 2}},
+			want:      mergedList,
+		},
+		{
+			name: "not enough stock for merged count",
+			prepare: func(f *fields) {
+				f.repMock.GetAllMock.When(minimock.AnyContext, fromUserID).Then([]domain.Item{{SKU: 100, Count: 2}}, nil)
+				f.repMock.GetAllMock.When(minimock.AnyContext, toUserID).Then([]domain.Item{{SKU: 100, Count: 1}}, nil)
+				f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{100}).Return(products, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(2, nil)
+			},
+			wantErr: ErrInsufficientStocks,
+		},
+		{
+			name: "loms service returned error",
+			prepare: func(f *fields) {
+				f.repMock.GetAllMock.When(minimock.AnyContext, fromUserID).Then([]domain.Item{{SKU: 100, Count: 2}}, nil)
+				f.repMock.GetAllMock.When(minimock.AnyContext, toUserID).Then(nil, domain.CartItemsNotFoundError{})
+				f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{100}).Return(products, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(0, fmt.Errorf("test error"))
+			},
+			wantErr: loms.ErrGetStockInfo,
+		},
+		{
+			name: "merged count overflows item count",
+			prepare: func(f *fields) {
+				f.repMock.GetAllMock.When(minimock.AnyContext, fromUserID).Then([]domain.Item{{SKU: 100, Count: math.MaxUint16}}, nil)
+				f.repMock.GetAllMock.When(minimock.AnyContext, toUserID).Then([]domain.Item{{SKU: 100, Count: 1}}, nil)
+			},
+			wantErr: ErrCountOverflow,
+		},
+		{
+			name: "product service does not know sku",
+			prepare: func(f *fields) {
+				f.repMock.GetAllMock.When(minimock.AnyContext, fromUserID).Then([]domain.Item{{SKU: 300, Count: 1}}, nil)
+				f.repMock.GetAllMock.When(minimock.AnyContext, toUserID).Then(nil, domain.CartItemsNotFoundError{})
+				f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{300}).Return(products, nil)
+			},
+			wantErr: product.ErrProductNotFound,
+		},
+		{
+			name: "failed delete of source cart",
+			prepare: func(f *fields) {
+				f.repMock.GetAllMock.When(minimock.AnyContext, fromUserID).Then([]domain.Item{{SKU: 100, Count: 3}}, nil)
+				f.repMock.GetAllMock.When(minimock.AnyContext, toUserID).Then(nil, domain.CartItemsNotFoundError{})
+				f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{100}).Return(products, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(10, nil)
+				f.txMock.RunInTxMock.Set(runInTx)
+				f.repMock.AddMock.Return(nil)
+				f.repMock.DeleteAllMock.ExpectUserIDParam2(fromUserID).Return(fmt.Errorf("test error"))
+			},
+			wantErr: ErrDeleteCartItems,
+		},
+		{
+			name: "failed add to target cart",
+			prepare: func(f *fields) {
+				f.repMock.GetAllMock.When(minimock.AnyContext, fromUserID).Then([]domain.Item{{SKU: 100, Count: 3}}, nil)
+				f.repMock.GetAllMock.When(minimock.AnyContext, toUserID).Then(nil, domain.CartItemsNotFoundError{})
+				f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{100}).Return(products, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(10, nil)
+				f.txMock.RunInTxMock.Set(runInTx)
+				f.repMock.AddMock.Return(fmt.Errorf("test error"))
+			},
+			wantErr: ErrAddCartItem,
+		},
+	}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			fieldsForTableTest := fields{
+				repMock:     mock.NewRepositoryMock(ctrl),
+				lomsMock:    mock.NewLomsServiceMock(ctrl),
+				productMock: mock.NewProductServiceMock(ctrl),
+				addedItems:  map[int64][]domain.Item{},
+				txMock:      mock.NewTxManagerMock(ctrl),
+			}
+
+			mergeHandler := New(fieldsForTableTest.repMock, fieldsForTableTest.lomsMock, fieldsForTableTest.productMock,
+				rate.NewLimiter(rate.Inf, 1), fieldsForTableTest.txMock)
+
+			tt.prepare(&fieldsForTableTest)
+			got, err := mergeHandler.MergeCarts(ctx, fromUserID, toUserID)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+
+			if tt.wantAdded != nil {
+				require.Equal(t, map[int64][]domain.Item{toUserID: tt.wantAdded}, fieldsForTableTest.addedItems)
+			}
+		})
+	}
+}
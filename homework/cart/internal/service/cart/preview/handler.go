@@ -0,0 +1,168 @@
+package preview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/otel"
+
+	"route256/cart/internal/app/errgroup"
+	"route256/cart/internal/clients/loms"
+	"route256/cart/internal/clients/product"
+	"route256/cart/internal/domain"
+)
+
+type (
+	productService interface {
+		GetProductsInfo(ctx context.Context, skus []uint32) (map[uint32]*domain.Product, error)
+	}
+
+	repository interface {
+		GetAll(ctx context.Context, userID int64) ([]domain.Item, error)
+	}
+
+	lomsService interface {
+		InfoStocks(ctx context.Context, SKU int64) (int, error)
+	}
+
+	limiter interface {
+		Wait(ctx context.Context) error
+	}
+
+	Handler struct {
+		repo           repository
+		productService productService
+		lomsService    lomsService
+		limiter        limiter
+	}
+)
+
+// maxConcurrentStockRequests bounds parallel loms requests of a single preview
+const maxConcurrentStockRequests = 10
+
+func New(repo repository, productService productService, lomsService lomsService, limiter limiter) *Handler {
+	return &Handler{
+		repo:           repo,
+		productService: productService,
+		lomsService:    lomsService,
+		limiter:        limiter,
+	}
+}
+
+// PreviewCheckout runs the checkout checks without creating an order and without clearing the cart.
+// The lines which would fail the checkout are returned in Failures instead of an error,
+// Items and TotalPrice contain only the lines which would be ordered.
+func (h *Handler) PreviewCheckout(ctx context.Context, userID int64) (*domain.CheckoutPreview, error) {
+	ctx, span := otel.Tracer("cart").Start(ctx, "service_preview_checkout")
+	defer span.End()
+
+	cartItems, err := h.repo.GetAll(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.CartItemsNotFoundError{}) {
+			return nil, domain.CartItemsNotFoundError{}
+		}
+
+		return nil, fmt.Errorf("repository.GetCart: %w", err)
+	}
+
+	sort.Slice(cartItems, func(i, j int) bool {
+		return cartItems[i].SKU < cartItems[j].SKU
+	})
+
+	skus := make([]uint32, len(cartItems))
+	for i, item := range cartItems {
+		skus[i] = uint32(item.SKU)
+	}
+
+	products, err := h.productService.GetProductsInfo(ctx, skus)
+	if err != nil {
+		return nil, fmt.Errorf("%w %w", product.ErrGetProductInfo, err)
+	}
+
+	availableCounts := make([]int, len(cartItems))
+	// stockFailures holds the failure reason of the lines whose stock could not be fetched
+	stockFailures := make([]string, len(cartItems))
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(maxConcurrentStockRequests)
+
+	for i, item := range cartItems {
+		eg.Go(func() error {
+			if err := h.limiter.Wait(egCtx); err != nil {
+				return fmt.Errorf("rate limiter error: %w", err)
+			}
+
+			availableCount, errInfoStocks := h.lomsService.InfoStocks(egCtx, item.SKU)
+			if errInfoStocks != nil {
+				// Строку без остатка отдаем в Failures, остальные строки превью считаются как обычно
+				stockFailures[i] = domain.PreviewFailureStockUnavailable
+				if errors.Is(errInfoStocks, loms.ErrStockNotFound) {
+					stockFailures[i] = domain.PreviewFailureStockNotFound
+				}
+
+				return nil
+			}
+
+			availableCounts[i] = availableCount
+
+			return nil
+		})
+	}
+
+	if err = eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	preview := &domain.CheckoutPreview{
+		Items:    make([]domain.OrderItem, 0, len(cartItems)),
+		Failures: make([]domain.PreviewFailure, 0),
+	}
+
+	for i, item := range cartItems {
+		productInfo, ok := products[uint32(item.SKU)]
+		if !ok || productInfo == nil {
+			preview.Failures = append(preview.Failures, domain.PreviewFailure{
+				SKU:            item.SKU,
+				Count:          item.Count,
+				AvailableCount: availableCounts[i],
+				Reason:         domain.PreviewFailureProductNotFound,
+			})
+
+			continue
+		}
+
+		if stockFailures[i] != "" {
+			preview.Failures = append(preview.Failures, domain.PreviewFailure{
+				SKU:    item.SKU,
+				Count:  item.Count,
+				Reason: stockFailures[i],
+			})
+
+			continue
+		}
+
+		if availableCounts[i] < int(item.Count) {
+			preview.Failures = append(preview.Failures, domain.PreviewFailure{
+				SKU:            item.SKU,
+				Count:          item.Count,
+				AvailableCount: availableCounts[i],
+				Reason:         domain.PreviewFailureInsufficientStocks,
+			})
+
+			continue
+		}
+
+		preview.Items = append(preview.Items, domain.OrderItem{
+			SKU:   item.SKU,
+			Count: item.Count,
+			Name:  productInfo.Name,
+			Price: productInfo.Price,
+		})
+
+		preview.TotalPrice += int(productInfo.Price) * int(item.Count)
+	}
+
+	return preview, nil
+}
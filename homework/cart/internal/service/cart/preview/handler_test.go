@@ -0,0 +1,153 @@
+package preview
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+	"golang.org/x/time/rate"
+
+	"route256/cart/internal/clients/loms"
+	"route256/cart/internal/clients/product"
+	"route256/cart/internal/domain"
+	"route256/cart/internal/service/cart/preview/mock"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestPreviewCheckoutWithPrepare(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	cartItems := []domain.Item{
+		{SKU: 983, Count: 2},
+		{SKU: 125, Count: 1},
+		{SKU: 400, Count: 3},
+	}
+
+	stocks := func(_ context.Context, sku int64) (int, error) {
+		switch sku {
+		case 983:
+			return 1, nil
+		default:
+			return 10, nil
+		}
+	}
+
+	type (
+		fields struct {
+			repMock     *mock.RepositoryMock
+			productMock *mock.ProductServiceMock
+			lomsMock    *mock.LomsServiceMock
+		}
+
+		data struct {
+			name        string
+			prepare     func(f *fields)
+			wantPreview *domain.CheckoutPreview
+			wantErr     error
+		}
+	)
+
+	testData := []data{{
+		name: "cart not found",
+		prepare: func(f *fields) {
+			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(nil, domain.CartItemsNotFoundError{})
+		},
+		wantErr: domain.CartItemsNotFoundError{},
+	}, {
+		name: "product service returned error",
+		prepare: func(f *fields) {
+			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(cartItems, nil)
+			f.productMock.GetProductsInfoMock.ExpectSkusParam2([]uint32{125, 400, 983}).Return(nil, fmt.Errorf("test error"))
+		},
+		wantErr: product.ErrGetProductInfo,
+	}, {
+		name: "lines without stock are reported with their own reason",
+		prepare: func(f *fields) {
+			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(cartItems, nil)
+			f.productMock.GetProductsInfoMock.Return(map[uint32]*domain.Product{
+				125: {Name: "Ручка", Price: 50},
+				400: {Name: "Тетрадь", Price: 20},
+				983: {Name: "Книга", Price: 300},
+			}, nil)
+			f.lomsMock.InfoStocksMock.Set(func(_ context.Context, sku int64) (int, error) {
+				switch sku {
+				case 400:
+					return 0, fmt.Errorf("%w %w", loms.ErrStockNotFound, fmt.Errorf("test error"))
+				case 983:
+					return 0, fmt.Errorf("test error")
+				default:
+					return 10, nil
+				}
+			})
+		},
+		wantPreview: &domain.CheckoutPreview{
+			Items:      []domain.OrderItem{{SKU: 125, Count: 1, Name: "Ручка", Price: 50}},
+			TotalPrice: 50,
+			Failures: []domain.PreviewFailure{
+				{SKU: 400, Count: 3, Reason: domain.PreviewFailureStockNotFound},
+				{SKU: 983, Count: 2, Reason: domain.PreviewFailureStockUnavailable},
+			},
+		},
+	}, {
+		name: "not found and insufficient lines are reported and left out of the total",
+		prepare: func(f *fields) {
+			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return(cartItems, nil)
+			f.productMock.GetProductsInfoMock.Return(map[uint32]*domain.Product{
+				125: {Name: "Ручка", Price: 50},
+				983: {Name: "Книга", Price: 300},
+			}, nil)
+			f.lomsMock.InfoStocksMock.Set(stocks)
+		},
+		wantPreview: &domain.CheckoutPreview{
+			Items: []domain.OrderItem{
+				{SKU: 125, Count: 1, Name: "Ручка", Price: 50},
+			},
+			TotalPrice: 50,
+			Failures: []domain.PreviewFailure{
+				{SKU: 400, Count: 3, AvailableCount: 10, Reason: domain.PreviewFailureProductNotFound},
+				{SKU: 983, Count: 2, AvailableCount: 1, Reason: domain.PreviewFailureInsufficientStocks},
+			},
+		},
+	}, {
+		name: "all lines pass",
+		prepare: func(f *fields) {
+			f.repMock.GetAllMock.ExpectUserIDParam2(123).Return([]domain.Item{{SKU: 125, Count: 4}}, nil)
+			f.productMock.GetProductsInfoMock.Return(map[uint32]*domain.Product{125: {Name: "Ручка", Price: 50}}, nil)
+			f.lomsMock.InfoStocksMock.Set(stocks)
+		},
+		wantPreview: &domain.CheckoutPreview{
+			Items:      []domain.OrderItem{{SKU: 125, Count: 4, Name: "Ручка", Price: 50}},
+			TotalPrice: 200,
+			Failures:   []domain.PreviewFailure{},
+		},
+	}}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			fieldsForTableTest := fields{
+				repMock:     mock.NewRepositoryMock(ctrl),
+				productMock: mock.NewProductServiceMock(ctrl),
+				lomsMock:    mock.NewLomsServiceMock(ctrl),
+			}
+
+			previewHandler := New(fieldsForTableTest.repMock, fieldsForTableTest.productMock, fieldsForTableTest.lomsMock, rate.NewLimiter(rate.Inf, 1))
+
+			tt.prepare(&fieldsForTableTest)
+			preview, err := previewHandler.PreviewCheckout(ctx, 123)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.wantPreview, preview)
+		})
+	}
+}
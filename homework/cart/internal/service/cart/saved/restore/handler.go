@@ -0,0 +1,102 @@
+package restore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+
+	"route256/cart/internal/clients/loms"
+	"route256/cart/internal/domain"
+)
+
+type (
+	cartRepository interface {
+		Add(ctx context.Context, userID int64, item domain.Item) error
+		GetCount(ctx context.Context, userID, skuID int64) (uint16, error)
+	}
+
+	savedRepository interface {
+		GetCount(ctx context.Context, userID, skuID int64) (uint16, error)
+		DeleteOne(ctx context.Context, userID, skuID int64) error
+	}
+
+	lomsService interface {
+		InfoStocks(ctx context.Context, SKU int64) (int, error)
+	}
+
+	txManager interface {
+		RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	Handler struct {
+		cartRepo    cartRepository
+		savedRepo   savedRepository
+		lomsService lomsService
+		txManager   txManager
+	}
+)
+
+var (
+	ErrItemNotSaved       = errors.New("item not saved for later")
+	ErrInsufficientStocks = errors.New("insufficient stocks")
+	ErrGetItemCount       = errors.New("failed to get item count")
+	ErrAddCartItem        = errors.New("failed to add cart item")
+	ErrDeleteSavedItem    = errors.New("failed to delete saved item")
+)
+
+func New(cartRepo cartRepository, savedRepo savedRepository, lomsService lomsService, txManager txManager) *Handler {
+	return &Handler{
+		cartRepo:    cartRepo,
+		savedRepo:   savedRepo,
+		lomsService: lomsService,
+		txManager:   txManager,
+	}
+}
+
+// RestoreItem moves the sku with its whole count from the saved for later list back to the user cart.
+func (h *Handler) RestoreItem(ctx context.Context, userID, skuID int64) error {
+	ctx, span := otel.Tracer("cart").Start(ctx, "service_restore_item")
+	defer span.End()
+
+	savedCount, err := h.savedRepo.GetCount(ctx, userID, skuID)
+	if err != nil {
+		return fmt.Errorf("%w %w", ErrGetItemCount, err)
+	}
+
+	if savedCount == 0 {
+		return fmt.Errorf("sku %d: %w", skuID, ErrItemNotSaved)
+	}
+
+	count, err := h.lomsService.InfoStocks(ctx, skuID)
+	if err != nil {
+		return fmt.Errorf("%w %w", loms.ErrGetStockInfo, err)
+	}
+
+	cartCount, err := h.cartRepo.GetCount(ctx, userID, skuID)
+	if err != nil {
+		return fmt.Errorf("%w %w", ErrGetItemCount, err)
+	}
+
+	if count < int(cartCount)+int(savedCount) {
+		return fmt.Errorf("%w", ErrInsufficientStocks)
+	}
+
+	// Перенос в одной транзакции, иначе сбой удаления из отложенных оставит товар в обоих списках
+	return h.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		err := h.cartRepo.Add(ctx, userID, domain.Item{
+			SKU:   skuID,
+			Count: savedCount,
+		})
+		if err != nil {
+			return fmt.Errorf("%w %w", ErrAddCartItem, err)
+		}
+
+		if err = h.savedRepo.DeleteOne(ctx, userID, skuID); err != nil {
+			return fmt.Errorf("%w %w", ErrDeleteSavedItem, err)
+		}
+
+		return nil
+	})
+}
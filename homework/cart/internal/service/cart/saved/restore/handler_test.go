@@ -0,0 +1,134 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+
+	"route256/cart/internal/clients/loms"
+	"route256/cart/internal/domain"
+	"route256/cart/internal/service/cart/saved/restore/mock"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestRestoreItemTableWithPrepare(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type (
+		fields struct {
+			cartRepMock   *mock.CartRepositoryMock
+			savedRepMock  *mock.SavedRepositoryMock
+			lomsMock      *mock.LomsServiceMock
+			txManagerMock *mock.TxManagerMock
+		}
+
+		data struct {
+			name    string
+			userID  int64
+			skuID   int64
+			prepare func(f *fields)
+			wantErr error
+		}
+	)
+
+	testData := []data{
+		{
+			name:   "item is not saved",
+			userID: 123,
+			skuID:  100,
+			prepare: func(f *fields) {
+				f.savedRepMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(0, nil)
+			},
+			wantErr: ErrItemNotSaved,
+		},
+		{
+			name:   "loms service returned error",
+			userID: 123,
+			skuID:  100,
+			prepare: func(f *fields) {
+				f.savedRepMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(2, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(0, fmt.Errorf("test error"))
+			},
+			wantErr: loms.ErrGetStockInfo,
+		},
+		{
+			name:   "not enough stock with items already in cart",
+			userID: 123,
+			skuID:  100,
+			prepare: func(f *fields) {
+				f.savedRepMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(2, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(3, nil)
+				f.cartRepMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(2, nil)
+			},
+			wantErr: ErrInsufficientStocks,
+		},
+		{
+			name:   "item is moved back to cart",
+			userID: 123,
+			skuID:  100,
+			prepare: func(f *fields) {
+				f.savedRepMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(2, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(10, nil)
+				f.cartRepMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(1, nil)
+				f.txManagerMock.RunInTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				f.cartRepMock.AddMock.ExpectUserIDParam2(123).ExpectItemParam3(domain.Item{
+					SKU:   100,
+					Count: 2,
+				}).Return(nil)
+				f.savedRepMock.DeleteOneMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "saved delete failed inside transaction",
+			userID: 123,
+			skuID:  100,
+			prepare: func(f *fields) {
+				f.savedRepMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(2, nil)
+				f.lomsMock.InfoStocksMock.ExpectSKUParam2(100).Return(10, nil)
+				f.cartRepMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(1, nil)
+				f.txManagerMock.RunInTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				f.cartRepMock.AddMock.ExpectUserIDParam2(123).ExpectItemParam3(domain.Item{
+					SKU:   100,
+					Count: 2,
+				}).Return(nil)
+				f.savedRepMock.DeleteOneMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(fmt.Errorf("test error"))
+			},
+			wantErr: ErrDeleteSavedItem,
+		},
+	}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			fieldsForTableTest := fields{
+				cartRepMock:   mock.NewCartRepositoryMock(ctrl),
+				savedRepMock:  mock.NewSavedRepositoryMock(ctrl),
+				lomsMock:      mock.NewLomsServiceMock(ctrl),
+				txManagerMock: mock.NewTxManagerMock(ctrl),
+			}
+
+			restoreHandler := New(fieldsForTableTest.cartRepMock, fieldsForTableTest.savedRepMock, fieldsForTableTest.lomsMock, fieldsForTableTest.txManagerMock)
+
+			tt.prepare(&fieldsForTableTest)
+			err := restoreHandler.RestoreItem(ctx, tt.userID, tt.skuID)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package save
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+
+	"route256/cart/internal/domain"
+)
+
+type (
+	cartRepository interface {
+		GetCount(ctx context.Context, userID, skuID int64) (uint16, error)
+		DeleteOne(ctx context.Context, userID, skuID int64) error
+	}
+
+	savedRepository interface {
+		Add(ctx context.Context, userID int64, item domain.Item) error
+	}
+
+	txManager interface {
+		RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	Handler struct {
+		cartRepo  cartRepository
+		savedRepo savedRepository
+		txManager txManager
+	}
+)
+
+var (
+	ErrItemNotInCart    = errors.New("item not in cart")
+	ErrGetCartItemCount = errors.New("failed to get cart item count")
+	ErrAddSavedItem     = errors.New("failed to add saved item")
+	ErrDeleteCartItem   = errors.New("failed to delete cart item")
+)
+
+func New(cartRepo cartRepository, savedRepo savedRepository, txManager txManager) *Handler {
+	return &Handler{
+		cartRepo:  cartRepo,
+		savedRepo: savedRepo,
+		txManager: txManager,
+	}
+}
+
+// SaveItem moves the sku with its whole count from the user cart to the saved for later list.
+func (h *Handler) SaveItem(ctx context.Context, userID, skuID int64) error {
+	ctx, span := otel.Tracer("cart").Start(ctx, "service_save_item")
+	defer span.End()
+
+	count, err := h.cartRepo.GetCount(ctx, userID, skuID)
+	if err != nil {
+		return fmt.Errorf("%w %w", ErrGetCartItemCount, err)
+	}
+
+	if count == 0 {
+		return fmt.Errorf("sku %d: %w", skuID, ErrItemNotInCart)
+	}
+
+	// Перенос в одной транзакции, иначе сбой удаления из корзины оставит товар в обоих списках
+	return h.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		err := h.savedRepo.Add(ctx, userID, domain.Item{
+			SKU:   skuID,
+			Count: count,
+		})
+		if err != nil {
+			return fmt.Errorf("%w %w", ErrAddSavedItem, err)
+		}
+
+		if err = h.cartRepo.DeleteOne(ctx, userID, skuID); err != nil {
+			return fmt.Errorf("%w %w", ErrDeleteCartItem, err)
+		}
+
+		return nil
+	})
+}
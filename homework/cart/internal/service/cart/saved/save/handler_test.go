@@ -0,0 +1,115 @@
+package save
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+
+	"route256/cart/internal/domain"
+	"route256/cart/internal/service/cart/saved/save/mock"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestSaveItemTableWithPrepare(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type (
+		fields struct {
+			cartRepMock   *mock.CartRepositoryMock
+			savedRepMock  *mock.SavedRepositoryMock
+			txManagerMock *mock.TxManagerMock
+		}
+
+		data struct {
+			name    string
+			userID  int64
+			skuID   int64
+			prepare func(f *fields)
+			wantErr error
+		}
+	)
+
+	testData := []data{
+		{
+			name:   "item is not in cart",
+			userID: 123,
+			skuID:  100,
+			prepare: func(f *fields) {
+				f.cartRepMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(0, nil)
+			},
+			wantErr: ErrItemNotInCart,
+		},
+		{
+			name:   "cart repository returned error",
+			userID: 123,
+			skuID:  100,
+			prepare: func(f *fields) {
+				f.cartRepMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(0, fmt.Errorf("test error"))
+			},
+			wantErr: ErrGetCartItemCount,
+		},
+		{
+			name:   "item is moved to saved list",
+			userID: 123,
+			skuID:  100,
+			prepare: func(f *fields) {
+				f.cartRepMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(3, nil)
+				f.txManagerMock.RunInTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				f.savedRepMock.AddMock.ExpectUserIDParam2(123).ExpectItemParam3(domain.Item{
+					SKU:   100,
+					Count: 3,
+				}).Return(nil)
+				f.cartRepMock.DeleteOneMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "cart delete failed inside transaction",
+			userID: 123,
+			skuID:  100,
+			prepare: func(f *fields) {
+				f.cartRepMock.GetCountMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(3, nil)
+				f.txManagerMock.RunInTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+				f.savedRepMock.AddMock.ExpectUserIDParam2(123).ExpectItemParam3(domain.Item{
+					SKU:   100,
+					Count: 3,
+				}).Return(nil)
+				f.cartRepMock.DeleteOneMock.ExpectUserIDParam2(123).ExpectSkuIDParam3(100).Return(fmt.Errorf("test error"))
+			},
+			wantErr: ErrDeleteCartItem,
+		},
+	}
+
+	for _, tt := range testData {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			fieldsForTableTest := fields{
+				cartRepMock:   mock.NewCartRepositoryMock(ctrl),
+				savedRepMock:  mock.NewSavedRepositoryMock(ctrl),
+				txManagerMock: mock.NewTxManagerMock(ctrl),
+			}
+
+			saveHandler := New(fieldsForTableTest.cartRepMock, fieldsForTableTest.savedRepMock, fieldsForTableTest.txManagerMock)
+
+			tt.prepare(&fieldsForTableTest)
+			err := saveHandler.SaveItem(ctx, tt.userID, tt.skuID)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
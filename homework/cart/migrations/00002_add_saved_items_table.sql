@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+create table if not exists saved_items
+(
+    user_id bigint not null,
+    sku     bigint not null,
+    count   int not null,
+    primary key (user_id, sku)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS saved_items CASCADE;
+-- +goose StatementEnd
@@ -16,6 +16,30 @@ var (
 		},
 	)
 
+	memoryCartsExpiredTotalCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "cart",
+			Name:      "memory_carts_expired_total_counter",
+			Help:      "Total number of abandoned carts evicted from the in-memory cart storage after ttl expiration",
+		},
+	)
+
+	memorySavedItemsTotalCounter = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "cart",
+			Name:      "memory_saved_items_total_counter",
+			Help:      "Total number of items in the in-memory saved for later storage",
+		},
+	)
+
+	memorySavedListsExpiredTotalCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "cart",
+			Name:      "memory_saved_lists_expired_total_counter",
+			Help:      "Total number of saved for later lists evicted from the in-memory storage after ttl expiration",
+		},
+	)
+
 	httpRequestsTotalCounter = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "cart",
@@ -32,6 +56,14 @@ var (
 		}, []string{"service", "handler"},
 	)
 
+	externalRequestsRetriesTotalCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "cart",
+			Name:      "external_requests_retries_total_counter",
+			Help:      "Total number of retried requests to external resources, categorized by handler and retry reason.",
+		}, []string{"handler", "reason"},
+	)
+
 	httpResponseStatusTotalCounter = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "cart",
@@ -63,12 +95,93 @@ var (
 			Buckets:   prometheus.DefBuckets,
 		},
 		[]string{"handler"})
+
+	productCacheRequestsTotalCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "cart",
+			Name:      "product_cache_requests_total_counter",
+			Help:      "Total number of product info cache lookups, categorized by result (hit or miss).",
+		}, []string{"result"},
+	)
+
+	grpcClientRequestsTotalCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "cart",
+			Name:      "grpc_client_requests_total_counter",
+			Help:      "Total number of gRPC requests sent by the service, categorized by handler.",
+		}, []string{"handler"},
+	)
+
+	grpcClientResponseStatusTotalCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "cart",
+			Name:      "grpc_client_response_status_total_counter",
+			Help:      "Total number of gRPC responses received by the service, categorized by handler and status_code.",
+		}, []string{"handler", "status_code"},
+	)
+
+	grpcClientRequestsDurationHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "cart",
+			Name:      "grpc_client_requests_duration_histogram",
+			Help:      "Duration of gRPC requests sent by the service in seconds, categorized by handler.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"handler"})
+
+	grpcClientRetriesTotalCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "cart",
+			Name:      "grpc_client_retries_total_counter",
+			Help:      "Total number of retried gRPC requests, categorized by handler and retry reason.",
+		}, []string{"handler", "reason"},
+	)
+
+	circuitBreakerStateGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "cart",
+			Name:      "circuit_breaker_state_gauge",
+			Help:      "State of the circuit breaker of an external resource: 0 closed, 1 half-open, 2 open.",
+		}, []string{"name"},
+	)
+
+	dbRequestsTotalCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "cart",
+		Name:      "db_requests_total_counter",
+		Help:      "Total number of database requests, categorized by query type.",
+	}, []string{"query_type"})
+
+	dbRequestsDurationHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "cart",
+			Name:      "db_requests_duration_histogram",
+			Help:      "Duration of database requests in seconds, categorized by query type and error status.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"query_type", "status"},
+	)
 )
 
 func UpdateMemoryCartItemsTotalCounter(cartItemsCount int) {
 	memoryCartItemsTotalCounter.Set(float64(cartItemsCount))
 }
 
+func AddMemoryCartItemsTotalCounter(cartItemsDelta int) {
+	memoryCartItemsTotalCounter.Add(float64(cartItemsDelta))
+}
+
+func AddMemoryCartsExpiredTotalCounter(cartsCount int) {
+	memoryCartsExpiredTotalCounter.Add(float64(cartsCount))
+}
+
+func UpdateMemorySavedItemsTotalCounter(savedItemsCount int) {
+	memorySavedItemsTotalCounter.Set(float64(savedItemsCount))
+}
+
+func AddMemorySavedListsExpiredTotalCounter(listsCount int) {
+	memorySavedListsExpiredTotalCounter.Add(float64(listsCount))
+}
+
 func IncHttpRequestsTotalCounter(labelValues ...string) {
 	httpRequestsTotalCounter.WithLabelValues(labelValues...).Inc()
 }
@@ -77,6 +190,10 @@ func IncExternalRequestsTotalCounter(labelValues ...string) {
 	externalRequestsTotalCounter.WithLabelValues(labelValues...).Inc()
 }
 
+func IncExternalRequestsRetriesTotalCounter(labelValues ...string) {
+	externalRequestsRetriesTotalCounter.WithLabelValues(labelValues...).Inc()
+}
+
 func ObserveHttpRequestsDurationHistogram(createdAt time.Time, labelValues ...string) {
 	httpRequestsDurationHistogram.WithLabelValues(labelValues...).Observe(time.Since(createdAt).Seconds())
 }
@@ -92,3 +209,35 @@ func IncHttpResponseStatusTotalCounter(labelValues ...string) {
 func IncExternalResponseStatusTotalCounter(labelValues ...string) {
 	externalResponseStatusTotalCounter.WithLabelValues(labelValues...).Inc()
 }
+
+func IncProductCacheRequestsTotalCounter(labelValues ...string) {
+	productCacheRequestsTotalCounter.WithLabelValues(labelValues...).Inc()
+}
+
+func IncGRPCClientRequestsTotalCounter(labelValues ...string) {
+	grpcClientRequestsTotalCounter.WithLabelValues(labelValues...).Inc()
+}
+
+func IncGRPCClientResponseStatusTotalCounter(labelValues ...string) {
+	grpcClientResponseStatusTotalCounter.WithLabelValues(labelValues...).Inc()
+}
+
+func ObserveGRPCClientRequestsDurationHistogram(createdAt time.Time, labelValues ...string) {
+	grpcClientRequestsDurationHistogram.WithLabelValues(labelValues...).Observe(time.Since(createdAt).Seconds())
+}
+
+func IncGRPCClientRetriesTotalCounter(labelValues ...string) {
+	grpcClientRetriesTotalCounter.WithLabelValues(labelValues...).Inc()
+}
+
+func SetCircuitBreakerState(state int, labelValues ...string) {
+	circuitBreakerStateGauge.WithLabelValues(labelValues...).Set(float64(state))
+}
+
+func IncDBRequestsTotalCounter(labelValues ...string) {
+	dbRequestsTotalCounter.WithLabelValues(labelValues...).Inc()
+}
+
+func ObserveDBRequestsDurationHistogram(startTime time.Time, labelValues ...string) {
+	dbRequestsDurationHistogram.WithLabelValues(labelValues...).Observe(time.Since(startTime).Seconds())
+}
@@ -0,0 +1,13 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	cartsuite "route256/cart/tests/suite"
+)
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(cartsuite.ItemS))
+}
@@ -0,0 +1,147 @@
+package cartsuite
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"route256/cart/internal/domain"
+	"route256/cart/internal/repository/db/cartitems"
+)
+
+const dbConnEnv = "CART_DB_CONN_TEST"
+
+type ItemS struct {
+	suite.Suite
+	cartItemsStorage *cartitems.Storage
+	ctx              context.Context
+	pool             *pgxpool.Pool
+}
+
+func (s *ItemS) SetupSuite() {
+	initEnv()
+
+	ctx := context.Background()
+
+	dbConnStr := os.Getenv(dbConnEnv)
+	pool, err := pgxpool.New(ctx, dbConnStr)
+
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.ctx = ctx
+	s.cartItemsStorage = cartitems.NewStorage(pool)
+	s.pool = pool
+}
+
+func (s *ItemS) TearDownSuite() {
+	s.pool.Close()
+}
+
+func (s *ItemS) TearDownTest() {
+	const query = `
+	TRUNCATE TABLE cart_items, saved_items;`
+
+	_, err := s.pool.Exec(s.ctx, query)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *ItemS) TestAddCartItemsDB() {
+	var userID int64 = 1
+
+	err := s.cartItemsStorage.Add(s.ctx, userID, domain.Item{SKU: 1076963, Count: 2})
+	require.NoError(s.T(), err)
+
+	err = s.cartItemsStorage.Add(s.ctx, userID, domain.Item{SKU: 1076963, Count: 3})
+	require.NoError(s.T(), err)
+
+	err = s.cartItemsStorage.Add(s.ctx, userID, domain.Item{SKU: 1148162, Count: 1})
+	require.NoError(s.T(), err)
+
+	items, err := s.cartItemsStorage.GetAll(s.ctx, userID)
+	require.NoError(s.T(), err)
+	require.ElementsMatch(s.T(), []domain.Item{
+		{SKU: 1076963, Count: 5},
+		{SKU: 1148162, Count: 1},
+	}, items)
+}
+
+func (s *ItemS) TestSetCartItemDB() {
+	var userID int64 = 1
+
+	err := s.cartItemsStorage.Add(s.ctx, userID, domain.Item{SKU: 1076963, Count: 2})
+	require.NoError(s.T(), err)
+
+	err = s.cartItemsStorage.Set(s.ctx, userID, domain.Item{SKU: 1076963, Count: 7})
+	require.NoError(s.T(), err)
+
+	count, err := s.cartItemsStorage.GetCount(s.ctx, userID, 1076963)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint16(7), count)
+}
+
+func (s *ItemS) TestGetCountMissingItemDB() {
+	count, err := s.cartItemsStorage.GetCount(s.ctx, 1, 1076963)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint16(0), count)
+}
+
+func (s *ItemS) TestGetAllEmptyCartDB() {
+	_, err := s.cartItemsStorage.GetAll(s.ctx, 1)
+	require.ErrorIs(s.T(), err, domain.CartItemsNotFoundError{})
+}
+
+func (s *ItemS) TestDeleteOneCartItemDB() {
+	var userID int64 = 1
+
+	err := s.cartItemsStorage.Add(s.ctx, userID, domain.Item{SKU: 1076963, Count: 2})
+	require.NoError(s.T(), err)
+
+	err = s.cartItemsStorage.Add(s.ctx, userID, domain.Item{SKU: 1148162, Count: 1})
+	require.NoError(s.T(), err)
+
+	err = s.cartItemsStorage.DeleteOne(s.ctx, userID, 1076963)
+	require.NoError(s.T(), err)
+
+	// удаление отсутствующего товара не ошибка
+	err = s.cartItemsStorage.DeleteOne(s.ctx, userID, 1076963)
+	require.NoError(s.T(), err)
+
+	items, err := s.cartItemsStorage.GetAll(s.ctx, userID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []domain.Item{{SKU: 1148162, Count: 1}}, items)
+}
+
+func (s *ItemS) TestDeleteAllCartItemsDB() {
+	err := s.cartItemsStorage.Add(s.ctx, 1, domain.Item{SKU: 1076963, Count: 2})
+	require.NoError(s.T(), err)
+
+	err = s.cartItemsStorage.Add(s.ctx, 2, domain.Item{SKU: 1076963, Count: 4})
+	require.NoError(s.T(), err)
+
+	err = s.cartItemsStorage.DeleteAll(s.ctx, 1)
+	require.NoError(s.T(), err)
+
+	_, err = s.cartItemsStorage.GetAll(s.ctx, 1)
+	require.ErrorIs(s.T(), err, domain.CartItemsNotFoundError{})
+
+	items, err := s.cartItemsStorage.GetAll(s.ctx, 2)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []domain.Item{{SKU: 1076963, Count: 4}}, items)
+}
+
+func initEnv() {
+	err := godotenv.Load("../../.env")
+
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+}
@@ -16,7 +16,7 @@ import (
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opentelemetry.io/otel"
@@ -34,6 +34,7 @@ import (
 	"route256/loms/internal/mw"
 	"route256/loms/internal/repository/db/orders"
 	"route256/loms/internal/repository/db/stocks"
+	"route256/loms/internal/repository/db/transaction"
 	lomsUsecase "route256/loms/internal/service/loms"
 	desc "route256/loms/pkg/api/loms/v1"
 	"route256/loms/pkg/logger"
@@ -58,8 +59,13 @@ const (
 	dbConnReadStrEnv  = "DB_CONN_READ"
 	dbConnWriteStrEnv = "DB_CONN_WRITE"
 	jaegerHost        = "JAEGER_HOST"
+
+	orderPaymentTimeoutEnv = "ORDER_PAYMENT_TIMEOUT"
 )
 
+// defaultOrderPaymentTimeout is used when ORDER_PAYMENT_TIMEOUT is not set
+const defaultOrderPaymentTimeout = 15 * time.Minute
+
 //go:embed assets
 var assets embed.FS
 
@@ -136,39 +142,39 @@ func main() {
 
 	defer cancel()
 
-	connWrite, err := pgx.Connect(ctx, os.Getenv(dbConnWriteStrEnv))
-
+	// Пул вместо одного соединения: pgx.Conn не потокобезопасен, а обработчики, джобы и транзакции работают параллельно
+	poolWrite, err := pgxpool.New(ctx, os.Getenv(dbConnWriteStrEnv))
 	if err != nil {
 		logger.Panicw(ctx, "failed to connect to write database", "error", err)
 	}
 
-	closerC.Add(func(ctx context.Context) error {
-		err = connWrite.Close(ctx)
-		if err != nil {
-			logger.Errorw(ctx, "failed to close write connection with database", "error", err)
-		}
+	closerC.Add(func(_ context.Context) error {
+		poolWrite.Close()
 
 		return nil
 	})
 
-	connRead, err := pgx.Connect(ctx, os.Getenv(dbConnReadStrEnv))
+	poolRead, err := pgxpool.New(ctx, os.Getenv(dbConnReadStrEnv))
 	if err != nil {
 		logger.Panicw(ctx, "failed to connect to read database", "error", err)
 	}
 
-	closerC.Add(func(ctx context.Context) error {
-		err = connRead.Close(ctx)
-		if err != nil {
-			logger.Errorw(ctx, "failed to close write connection with database", "error", err)
-		}
+	closerC.Add(func(_ context.Context) error {
+		poolRead.Close()
 
 		return nil
 	})
 
-	useCase := lomsUsecase.NewService(orders.NewStorage(connRead, connWrite), stocks.NewStorage(connRead, connWrite))
+	ordersStorage := orders.NewStorage(poolRead, poolWrite)
+
+	useCase := lomsUsecase.NewService(
+		ordersStorage,
+		stocks.NewStorage(poolRead, poolWrite),
+		transaction.NewManager(poolWrite),
+	)
 	controller := loms.NewService(useCase)
 
-	job := jobs.InitJob(connRead, connWrite)
+	job := jobs.InitJob(poolRead, poolWrite)
 
 	closerC.Add(func(ctx context.Context) error {
 		job.Shutdown()
@@ -180,6 +186,18 @@ func main() {
 		job.Run()
 	}()
 
+	cancelUnpaidOrdersJob := jobs.InitCancelUnpaidOrdersJob(ordersStorage, useCase, getOrderPaymentTimeout(ctx))
+
+	closerC.Add(func(ctx context.Context) error {
+		cancelUnpaidOrdersJob.Shutdown()
+
+		return nil
+	})
+
+	go func() {
+		cancelUnpaidOrdersJob.Run()
+	}()
+
 	// Сгенерированный метод из прото
 	desc.RegisterLOMSServer(grpcServer, controller)
 
@@ -321,6 +339,20 @@ func initTracerProvider(ctx context.Context) *trace.TracerProvider {
 	return traceProvider
 }
 
+func getOrderPaymentTimeout(ctx context.Context) time.Duration {
+	value := os.Getenv(orderPaymentTimeoutEnv)
+	if value == "" {
+		return defaultOrderPaymentTimeout
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		logger.Panicw(ctx, "failed to get order payment timeout", "error", err, "value", value)
+	}
+
+	return timeout
+}
+
 func initEnv(ctx context.Context) {
 	err := godotenv.Load()
 
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/codes"
 
 	"route256/loms/internal/app/definitions/params"
+	"route256/loms/internal/domain"
 	"route256/loms/internal/repository/memory/orders"
 	servicepb "route256/loms/pkg/api/loms/v1"
 	"route256/loms/pkg/prometheus"
@@ -36,6 +37,10 @@ func (s *Service) CancelOrder(ctx context.Context, in *servicepb.CancelOrderRequ
 			return nil, GetErrorResponse(ctx, codes.NotFound, handlerName, err)
 		}
 
+		if errors.Is(err, domain.InsufficientStocksError{}) || errors.Is(err, domain.OrderStatusTransitionError{}) {
+			return nil, GetErrorResponse(ctx, codes.FailedPrecondition, handlerName, err)
+		}
+
 		return nil, GetErrorResponse(ctx, codes.Internal, handlerName, err)
 	}
 
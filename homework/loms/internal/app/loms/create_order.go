@@ -27,7 +27,7 @@ func (s *Service) CreateOrder(ctx context.Context, in *servicepb.CreateOrderRequ
 
 	prometheus.IncGRPCRequestsTotalCounter("create_order")
 
-	orderID, err := s.impl.CreateOrder(ctx, in.User, repackItems(in.Items))
+	orderID, err := s.impl.CreateOrder(ctx, in.User, repackItems(in.Items), getIdempotencyKey(ctx))
 	if err != nil {
 		return nil, GetErrorResponse(ctx, codes.FailedPrecondition, handlerName, err)
 	}
@@ -0,0 +1,85 @@
+package loms
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"route256/loms/internal/domain"
+	servicepb "route256/loms/pkg/api/loms/v1"
+	"route256/loms/pkg/prometheus"
+)
+
+func (s *Service) ListOrders(ctx context.Context, in *servicepb.ListOrdersRequest) (*servicepb.ListOrdersResponse, error) {
+	handlerName := "GET /v1/orders"
+
+	ctx, _ = getCtxByTraceID(ctx)
+
+	ctx, span := otel.Tracer("loms").Start(ctx, "handler_list_orders")
+	defer span.End()
+
+	defer func(createdAt time.Time) {
+		prometheus.ObserveGRPCRequestsDurationHistogram(createdAt, "list_orders")
+	}(time.Now())
+
+	prometheus.IncGRPCRequestsTotalCounter("list_orders")
+
+	filter := domain.OrdersFilter{
+		UserID:   in.GetUserId(),
+		Statuses: in.GetStatuses(),
+	}
+
+	if in.GetCreatedFrom() != nil {
+		filter.CreatedFrom = in.GetCreatedFrom().AsTime()
+	}
+
+	if in.GetCreatedTo() != nil {
+		filter.CreatedTo = in.GetCreatedTo().AsTime()
+	}
+
+	orders, nextPageToken, err := s.impl.ListOrders(ctx, filter, in.GetPageToken(), in.GetPageSize())
+	if err != nil {
+		if errors.Is(err, domain.InvalidPageTokenError{}) {
+			return nil, GetErrorResponse(ctx, codes.InvalidArgument, handlerName, err)
+		}
+
+		return nil, GetErrorResponse(ctx, codes.Internal, handlerName, err)
+	}
+
+	prometheus.IncGRPCResponseStatusTotalCounter(strconv.FormatUint(uint64(codes.OK), 10), handlerName)
+
+	return &servicepb.ListOrdersResponse{
+		Orders:        repackOrdersToProto(orders),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func repackOrdersToProto(orders []domain.Order) []*servicepb.Order {
+	protoOrders := make([]*servicepb.Order, len(orders))
+
+	for i, order := range orders {
+		items := make([]*servicepb.Item, len(order.Items))
+
+		for j, n := range order.Items {
+			items[j] = &servicepb.Item{
+				Sku:   n.SKU,
+				Count: n.Count,
+			}
+		}
+
+		protoOrders[i] = &servicepb.Order{
+			OrderId:   order.ID,
+			Status:    order.Status,
+			User:      order.UserID,
+			Items:     items,
+			CreatedAt: timestamppb.New(order.CreatedAt),
+		}
+	}
+
+	return protoOrders
+}
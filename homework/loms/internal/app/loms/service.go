@@ -16,13 +16,17 @@ import (
 	"route256/loms/pkg/prometheus"
 )
 
+// idempotencyKeyHeader deduplicates repeated CreateOrder requests, cart sends its checkout Idempotency-Key in it
+const idempotencyKeyHeader = "idempotency-key"
+
 var _ servicepb.LOMSServer = (*Service)(nil)
 
 type LOMSService interface {
 	CancelOrder(ctx context.Context, orderID int64) error
-	CreateOrder(ctx context.Context, userID int64, items []domain.Item) (*int64, error)
+	CreateOrder(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) (*int64, error)
 	InfoOrder(ctx context.Context, orderID int64) (*domain.Order, error)
 	InfoStocks(ctx context.Context, sku uint32) (*int64, error)
+	ListOrders(ctx context.Context, filter domain.OrdersFilter, pageToken string, pageSize uint32) ([]domain.Order, string, error)
 	PayOrder(ctx context.Context, orderID int64) error
 }
 
@@ -35,6 +39,17 @@ func NewService(impl LOMSService) *Service {
 	return &Service{impl: impl}
 }
 
+// getIdempotencyKey returns the idempotency-key of the incoming metadata, empty if the caller didn't send it.
+func getIdempotencyKey(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if len(md[idempotencyKeyHeader]) == 0 {
+		return ""
+	}
+
+	return md[idempotencyKeyHeader][0]
+}
+
 func GetErrorResponse(ctx context.Context, code codes.Code, handlerName string, err error) error {
 	prometheus.IncGRPCResponseStatusTotalCounter(strconv.FormatUint(uint64(code), 10), handlerName)
 
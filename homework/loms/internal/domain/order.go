@@ -1,8 +1,47 @@
 package domain
 
+import (
+	"fmt"
+	"time"
+)
+
 type Order struct {
-	ID     int64
-	Status string
-	UserID int64
-	Items  []Item
+	ID        int64
+	Status    string
+	UserID    int64
+	Items     []Item
+	CreatedAt time.Time
+}
+
+// OrdersFilter narrows the orders list, the zero value fields don't filter
+type OrdersFilter struct {
+	UserID      int64
+	Statuses    []string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	After       *OrderCursor
+	Limit       int32
+}
+
+// OrderCursor points to the last order of the previous page, the orders are listed newest first
+type OrderCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// InvalidPageTokenError is returned when a page token of the orders list can't be decoded
+type InvalidPageTokenError struct{}
+
+func (_ InvalidPageTokenError) Error() string {
+	return "invalid page token"
+}
+
+// OrderExistsError is returned when an order with the same idempotency key has already been created
+type OrderExistsError struct {
+	OrderID int64
+	Status  string
+}
+
+func (e OrderExistsError) Error() string {
+	return fmt.Sprintf("order %d with the same idempotency key already exists", e.OrderID)
 }
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+
+	orderStatus "route256/loms/internal/app/definitions"
+)
+
+// orderStatusTransitions lists the statuses an order may move to from each status,
+// failed, payed and cancelled are final
+var orderStatusTransitions = map[string][]string{
+	orderStatus.New:             {orderStatus.AwaitingPayment, orderStatus.Failed},
+	orderStatus.AwaitingPayment: {orderStatus.Payed, orderStatus.Cancelled},
+}
+
+// OrderStatusTransitionError is returned when an order can't move from its current status to the requested one
+type OrderStatusTransitionError struct {
+	OrderID int64
+	From    string
+	To      string
+}
+
+func (e OrderStatusTransitionError) Error() string {
+	return fmt.Sprintf("order %d can't change status from %q to %q", e.OrderID, e.From, e.To)
+}
+
+// Is matches any OrderStatusTransitionError regardless of the order and statuses
+func (e OrderStatusTransitionError) Is(target error) bool {
+	_, ok := target.(OrderStatusTransitionError)
+
+	return ok
+}
+
+// CanChangeOrderStatus reports whether an order in status from may move to status to
+func CanChangeOrderStatus(from, to string) bool {
+	for _, status := range orderStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PreviousOrderStatuses returns the statuses from which an order may move to status to
+func PreviousOrderStatuses(to string) []string {
+	var statuses []string
+
+	for from := range orderStatusTransitions {
+		if CanChangeOrderStatus(from, to) {
+			statuses = append(statuses, from)
+		}
+	}
+
+	slices.Sort(statuses)
+
+	return statuses
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	orderStatus "route256/loms/internal/app/definitions"
+)
+
+func TestCanChangeOrderStatus(t *testing.T) {
+	t.Parallel()
+
+	testData := []struct {
+		from string
+		to   string
+		want bool
+	}{
+		{from: orderStatus.New, to: orderStatus.AwaitingPayment, want: true},
+		{from: orderStatus.New, to: orderStatus.Failed, want: true},
+		{from: orderStatus.New, to: orderStatus.Payed, want: false},
+		{from: orderStatus.AwaitingPayment, to: orderStatus.Payed, want: true},
+		{from: orderStatus.AwaitingPayment, to: orderStatus.Cancelled, want: true},
+		{from: orderStatus.Cancelled, to: orderStatus.Payed, want: false},
+		{from: orderStatus.Payed, to: orderStatus.Cancelled, want: false},
+		{from: orderStatus.Failed, to: orderStatus.Cancelled, want: false},
+		{from: orderStatus.Payed, to: orderStatus.Payed, want: false},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.from+" -> "+tt.to, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, CanChangeOrderStatus(tt.from, tt.to))
+		})
+	}
+}
+
+func TestPreviousOrderStatuses(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{orderStatus.AwaitingPayment}, PreviousOrderStatuses(orderStatus.Cancelled))
+	require.Equal(t, []string{orderStatus.New}, PreviousOrderStatuses(orderStatus.Failed))
+	require.Empty(t, PreviousOrderStatuses(orderStatus.New))
+}
+
+func TestOrderStatusTransitionError(t *testing.T) {
+	t.Parallel()
+
+	err := OrderStatusTransitionError{OrderID: 5, From: orderStatus.Cancelled, To: orderStatus.Payed}
+
+	require.ErrorIs(t, err, OrderStatusTransitionError{})
+	require.EqualError(t, err, `order 5 can't change status from "cancelled" to "payed"`)
+}
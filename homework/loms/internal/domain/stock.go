@@ -1,8 +1,26 @@
 package domain
 
+import "fmt"
+
 type Stock struct {
 	ID         int64
 	Sku        uint32
 	TotalCount int64
 	Reserved   int64
 }
+
+// InsufficientStocksError is returned when a stock of the SKU can't cover the requested count
+type InsufficientStocksError struct {
+	SKU uint32
+}
+
+func (e InsufficientStocksError) Error() string {
+	return fmt.Sprintf("insufficient stocks for sku %d", e.SKU)
+}
+
+// Is matches any InsufficientStocksError regardless of the SKU
+func (e InsufficientStocksError) Is(target error) bool {
+	_, ok := target.(InsufficientStocksError)
+
+	return ok
+}
@@ -0,0 +1,112 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	orderStatus "route256/loms/internal/app/definitions"
+	"route256/loms/internal/domain"
+	"route256/loms/pkg/logger"
+)
+
+type (
+	UnpaidOrdersRepository interface {
+		GetIDsByStatus(ctx context.Context, status string, createdBefore time.Time, afterID int64, limit int32) ([]int64, error)
+	}
+	OrderCanceller interface {
+		CancelOrder(ctx context.Context, orderID int64) error
+	}
+)
+
+// CancelUnpaidOrdersJob cancels the orders left in awaiting payment longer than the payment timeout,
+// so their reserved stocks return to sale
+type CancelUnpaidOrdersJob struct {
+	ordersRepository UnpaidOrdersRepository
+	canceller        OrderCanceller
+	paymentTimeout   time.Duration
+	done             chan bool
+	now              func() time.Time
+}
+
+func InitCancelUnpaidOrdersJob(ordersRepository UnpaidOrdersRepository, canceller OrderCanceller, paymentTimeout time.Duration) *CancelUnpaidOrdersJob {
+	return &CancelUnpaidOrdersJob{
+		ordersRepository: ordersRepository,
+		canceller:        canceller,
+		paymentTimeout:   paymentTimeout,
+		done:             make(chan bool),
+		now:              time.Now,
+	}
+}
+
+func (c *CancelUnpaidOrdersJob) Shutdown() {
+	c.done <- true
+}
+
+var (
+	cancelUnpaidOrdersRate  = 30 * time.Second
+	cancelUnpaidOrdersLimit = 100
+)
+
+func (c *CancelUnpaidOrdersJob) Run() {
+	ticker := time.NewTicker(cancelUnpaidOrdersRate)
+	defer ticker.Stop()
+	defer close(c.done)
+
+	ctx := context.Background()
+
+	for {
+		select {
+		case <-c.done:
+			logger.Infow(ctx, "CancelUnpaidOrdersJob shutdown complete")
+			return
+		case <-ticker.C:
+			c.cancelOrders(ctx)
+		}
+	}
+}
+
+func (c *CancelUnpaidOrdersJob) cancelOrders(ctx context.Context) {
+	createdBefore := c.now().Add(-c.paymentTimeout)
+
+	// Курсор по id: заказы, которые не удалось отменить, не занимают каждую следующую страницу
+	var afterID int64
+
+	for {
+		orderIDs, err := c.ordersRepository.GetIDsByStatus(ctx, orderStatus.AwaitingPayment, createdBefore, afterID, int32(cancelUnpaidOrdersLimit))
+		if err != nil {
+			logger.Errorw(ctx, "Error by getting unpaid orders", "error", err)
+
+			return
+		}
+
+		for _, orderID := range orderIDs {
+			c.cancelOrder(ctx, orderID)
+		}
+
+		if len(orderIDs) < cancelUnpaidOrdersLimit {
+			return
+		}
+
+		afterID = orderIDs[len(orderIDs)-1]
+	}
+}
+
+func (c *CancelUnpaidOrdersJob) cancelOrder(ctx context.Context, orderID int64) {
+	// Отмена идёт тем же путём, что и CancelOrder: резерв снимается, в outbox пишется order-cancelled
+	err := c.canceller.CancelOrder(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, domain.OrderStatusTransitionError{}) {
+			// Заказ успели оплатить или отменить после выборки
+			logger.Infow(ctx, "Unpaid order is no longer awaiting payment", "orderID", orderID, "error", err)
+
+			return
+		}
+
+		logger.Errorw(ctx, "Error by cancelling unpaid order", "error", err, "orderID", orderID)
+
+		return
+	}
+
+	logger.Infow(ctx, "Unpaid order cancelled by payment timeout", "orderID", orderID)
+}
@@ -0,0 +1,109 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+
+	orderStatus "route256/loms/internal/app/definitions"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/jobs/mock"
+)
+
+func TestCancelUnpaidOrdersWithPrepare(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	paymentTimeout := 15 * time.Minute
+	createdBefore := now.Add(-paymentTimeout)
+
+	type (
+		fields struct {
+			ordersRepMock *mock.UnpaidOrdersRepositoryMock
+			cancellerMock *mock.OrderCancellerMock
+		}
+
+		data struct {
+			name    string
+			prepare func(f *fields)
+		}
+	)
+
+	testData := []data{{
+		name: "Cancels all unpaid orders",
+		prepare: func(f *fields) {
+			f.ordersRepMock.GetIDsByStatusMock.
+				Expect(ctx, orderStatus.AwaitingPayment, createdBefore, 0, int32(cancelUnpaidOrdersLimit)).
+				Return([]int64{1, 2}, nil)
+			f.cancellerMock.CancelOrderMock.When(ctx, 1).Then(nil)
+			f.cancellerMock.CancelOrderMock.When(ctx, 2).Then(nil)
+		},
+	}, {
+		name: "Continues after a failed cancellation",
+		prepare: func(f *fields) {
+			f.ordersRepMock.GetIDsByStatusMock.
+				Expect(ctx, orderStatus.AwaitingPayment, createdBefore, 0, int32(cancelUnpaidOrdersLimit)).
+				Return([]int64{1, 2, 3}, nil)
+			f.cancellerMock.CancelOrderMock.When(ctx, 1).Then(domain.OrderStatusTransitionError{
+				OrderID: 1,
+				From:    orderStatus.Payed,
+				To:      orderStatus.Cancelled,
+			})
+			f.cancellerMock.CancelOrderMock.When(ctx, 2).Then(fmt.Errorf("connection lost"))
+			f.cancellerMock.CancelOrderMock.When(ctx, 3).Then(nil)
+		},
+	}, {
+		name: "Moves past the orders failing to cancel",
+		prepare: func(f *fields) {
+			failingIDs := make([]int64, cancelUnpaidOrdersLimit)
+			for i := range failingIDs {
+				failingIDs[i] = int64(i + 1)
+			}
+
+			f.ordersRepMock.GetIDsByStatusMock.
+				When(ctx, orderStatus.AwaitingPayment, createdBefore, 0, int32(cancelUnpaidOrdersLimit)).
+				Then(failingIDs, nil)
+			f.ordersRepMock.GetIDsByStatusMock.
+				When(ctx, orderStatus.AwaitingPayment, createdBefore, int64(cancelUnpaidOrdersLimit), int32(cancelUnpaidOrdersLimit)).
+				Then([]int64{int64(cancelUnpaidOrdersLimit) + 1}, nil)
+			f.cancellerMock.CancelOrderMock.Set(func(_ context.Context, orderID int64) error {
+				if orderID <= int64(cancelUnpaidOrdersLimit) {
+					return fmt.Errorf("connection lost")
+				}
+
+				return nil
+			})
+			f.cancellerMock.CancelOrderMock.Times(uint64(cancelUnpaidOrdersLimit) + 1)
+		},
+	}, {
+		name: "Repository error",
+		prepare: func(f *fields) {
+			f.ordersRepMock.GetIDsByStatusMock.
+				Expect(ctx, orderStatus.AwaitingPayment, createdBefore, 0, int32(cancelUnpaidOrdersLimit)).
+				Return(nil, fmt.Errorf("connection lost"))
+		},
+	}}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			f := fields{
+				ordersRepMock: mock.NewUnpaidOrdersRepositoryMock(ctrl),
+				cancellerMock: mock.NewOrderCancellerMock(ctrl),
+			}
+			tt.prepare(&f)
+
+			job := InitCancelUnpaidOrdersJob(f.ordersRepMock, f.cancellerMock, paymentTimeout)
+			job.now = func() time.Time { return now }
+
+			job.cancelOrders(ctx)
+		})
+	}
+}
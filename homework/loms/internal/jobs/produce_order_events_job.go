@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	"route256/loms/internal/domain"
 	"route256/loms/internal/repository/db/orders"
@@ -21,14 +21,14 @@ type OrdersRepository interface {
 type ProduceOrderEventsJob struct {
 	ordersRepository OrdersRepository
 	done             chan bool
-	connWrite        *pgx.Conn
+	poolWrite        *pgxpool.Pool
 }
 
-func InitJob(connRead, connWrite *pgx.Conn) *ProduceOrderEventsJob {
+func InitJob(poolRead, poolWrite *pgxpool.Pool) *ProduceOrderEventsJob {
 	return &ProduceOrderEventsJob{
-		ordersRepository: orders.NewStorage(connRead, connWrite),
+		ordersRepository: orders.NewStorage(poolRead, poolWrite),
 		done:             make(chan bool),
-		connWrite:        connWrite,
+		poolWrite:        poolWrite,
 	}
 }
 
@@ -9,9 +9,11 @@ import (
 )
 
 type Order struct {
-	ID     int32
-	UserID int64
-	Status string
+	ID             int32
+	UserID         int64
+	Status         string
+	CreatedAt      pgtype.Timestamptz
+	IdempotencyKey pgtype.Text
 }
 
 type OrderItem struct {
@@ -28,10 +30,3 @@ type OutboxOrderEvent struct {
 	WasSent   bool
 	CreatedAt pgtype.Timestamptz
 }
-
-type Stock struct {
-	ID         int32
-	Sku        int32
-	TotalCount int32
-	Reserved   int32
-}
@@ -1,26 +1,58 @@
 -- name: CreateOrder :one
-INSERT INTO orders(user_id, status)
-VALUES ($1, $2)
+INSERT INTO orders(user_id, status, idempotency_key)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, idempotency_key) DO NOTHING
 RETURNING id;
 
 -- name: CreateOrderItem :exec
 INSERT INTO order_items(order_id, sku, count)
 VALUES ($1, $2, $3);
 
--- name: SetOrderStatus :exec
+-- name: SetOrderStatus :execrows
 UPDATE orders
-SET status = $1
-WHERE id = $2;
+SET status = sqlc.arg('status')
+WHERE id = sqlc.arg('id')
+  AND status = ANY (sqlc.arg('from_statuses')::varchar[]);
 
 -- name: GetOrder :one
 SELECT * FROM orders
 WHERE id = $1
 LIMIT 1;
 
+-- name: GetOrderByIdempotencyKey :one
+SELECT * FROM orders
+WHERE user_id = $1
+  AND idempotency_key = $2
+LIMIT 1;
+
+-- name: GetOrderIDsByStatus :many
+SELECT id FROM orders
+WHERE status = $1
+  AND created_at < $2
+  AND id > $3
+ORDER BY id
+LIMIT $4;
+
 -- name: GetOrderItems :many
 SELECT * FROM order_items
 WHERE order_id = $1;
 
+-- name: ListOrders :many
+SELECT * FROM orders
+WHERE (sqlc.narg('user_id')::bigint IS NULL OR user_id = sqlc.narg('user_id'))
+  AND (cardinality(sqlc.arg('statuses')::varchar[]) = 0 OR status = ANY (sqlc.arg('statuses')::varchar[]))
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR created_at >= sqlc.narg('created_from'))
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR created_at < sqlc.narg('created_to'))
+  AND (sqlc.narg('after_created_at')::timestamptz IS NULL
+    OR (created_at, id) < (sqlc.narg('after_created_at'), sqlc.narg('after_id')::integer))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('page_limit');
+
+-- name: GetOrderItemsByOrderIDs :many
+SELECT * FROM order_items
+WHERE order_id = ANY (sqlc.arg('order_ids')::bigint[])
+ORDER BY order_id, id;
+
 -- name: CreateOutboxOrderEvent :exec
 INSERT INTO outbox_order_events(order_id, event_type)
 VALUES ($1, $2);
@@ -7,21 +7,25 @@ package orders
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createOrder = `-- name: CreateOrder :one
-INSERT INTO orders(user_id, status)
-VALUES ($1, $2)
+INSERT INTO orders(user_id, status, idempotency_key)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, idempotency_key) DO NOTHING
 RETURNING id
 `
 
 type CreateOrderParams struct {
-	UserID int64
-	Status string
+	UserID         int64
+	Status         string
+	IdempotencyKey pgtype.Text
 }
 
 func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (int32, error) {
-	row := q.db.QueryRow(ctx, createOrder, arg.UserID, arg.Status)
+	row := q.db.QueryRow(ctx, createOrder, arg.UserID, arg.Status, arg.IdempotencyKey)
 	var id int32
 	err := row.Scan(&id)
 	return id, err
@@ -59,7 +63,7 @@ func (q *Queries) CreateOutboxOrderEvent(ctx context.Context, arg CreateOutboxOr
 }
 
 const getOrder = `-- name: GetOrder :one
-SELECT id, user_id, status FROM orders
+SELECT id, user_id, status, created_at, idempotency_key FROM orders
 WHERE id = $1
 LIMIT 1
 `
@@ -67,10 +71,82 @@ LIMIT 1
 func (q *Queries) GetOrder(ctx context.Context, id int32) (Order, error) {
 	row := q.db.QueryRow(ctx, getOrder, id)
 	var i Order
-	err := row.Scan(&i.ID, &i.UserID, &i.Status)
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.IdempotencyKey,
+	)
 	return i, err
 }
 
+const getOrderByIdempotencyKey = `-- name: GetOrderByIdempotencyKey :one
+SELECT id, user_id, status, created_at, idempotency_key FROM orders
+WHERE user_id = $1
+  AND idempotency_key = $2
+LIMIT 1
+`
+
+type GetOrderByIdempotencyKeyParams struct {
+	UserID         int64
+	IdempotencyKey pgtype.Text
+}
+
+func (q *Queries) GetOrderByIdempotencyKey(ctx context.Context, arg GetOrderByIdempotencyKeyParams) (Order, error) {
+	row := q.db.QueryRow(ctx, getOrderByIdempotencyKey, arg.UserID, arg.IdempotencyKey)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.IdempotencyKey,
+	)
+	return i, err
+}
+
+const getOrderIDsByStatus = `-- name: GetOrderIDsByStatus :many
+SELECT id FROM orders
+WHERE status = $1
+  AND created_at < $2
+  AND id > $3
+ORDER BY id
+LIMIT $4
+`
+
+type GetOrderIDsByStatusParams struct {
+	Status    string
+	CreatedAt pgtype.Timestamptz
+	ID        int32
+	Limit     int32
+}
+
+func (q *Queries) GetOrderIDsByStatus(ctx context.Context, arg GetOrderIDsByStatusParams) ([]int32, error) {
+	rows, err := q.db.Query(ctx, getOrderIDsByStatus,
+		arg.Status,
+		arg.CreatedAt,
+		arg.ID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getOrderItems = `-- name: GetOrderItems :many
 SELECT id, order_id, sku, count FROM order_items
 WHERE order_id = $1
@@ -101,6 +177,37 @@ func (q *Queries) GetOrderItems(ctx context.Context, orderID int64) ([]OrderItem
 	return items, nil
 }
 
+const getOrderItemsByOrderIDs = `-- name: GetOrderItemsByOrderIDs :many
+SELECT id, order_id, sku, count FROM order_items
+WHERE order_id = ANY ($1::bigint[])
+ORDER BY order_id, id
+`
+
+func (q *Queries) GetOrderItemsByOrderIDs(ctx context.Context, orderIds []int64) ([]OrderItem, error) {
+	rows, err := q.db.Query(ctx, getOrderItemsByOrderIDs, orderIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrderItem
+	for rows.Next() {
+		var i OrderItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderID,
+			&i.Sku,
+			&i.Count,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUnsentOutboxOrderEvents = `-- name: GetUnsentOutboxOrderEvents :many
 SELECT id, order_id, event_type, was_sent, created_at FROM outbox_order_events
 WHERE was_sent = false
@@ -133,6 +240,62 @@ func (q *Queries) GetUnsentOutboxOrderEvents(ctx context.Context, limit int32) (
 	return items, nil
 }
 
+const listOrders = `-- name: ListOrders :many
+SELECT id, user_id, status, created_at, idempotency_key FROM orders
+WHERE ($1::bigint IS NULL OR user_id = $1)
+  AND (cardinality($2::varchar[]) = 0 OR status = ANY ($2::varchar[]))
+  AND ($3::timestamptz IS NULL OR created_at >= $3)
+  AND ($4::timestamptz IS NULL OR created_at < $4)
+  AND ($5::timestamptz IS NULL
+    OR (created_at, id) < ($5, $6::integer))
+ORDER BY created_at DESC, id DESC
+LIMIT $7
+`
+
+type ListOrdersParams struct {
+	UserID         pgtype.Int8
+	Statuses       []string
+	CreatedFrom    pgtype.Timestamptz
+	CreatedTo      pgtype.Timestamptz
+	AfterCreatedAt pgtype.Timestamptz
+	AfterID        pgtype.Int4
+	PageLimit      int32
+}
+
+func (q *Queries) ListOrders(ctx context.Context, arg ListOrdersParams) ([]Order, error) {
+	rows, err := q.db.Query(ctx, listOrders,
+		arg.UserID,
+		arg.Statuses,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Order
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.IdempotencyKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const markAsSentOutboxOrderEvent = `-- name: MarkAsSentOutboxOrderEvent :exec
 UPDATE outbox_order_events
 SET was_sent = true
@@ -144,18 +307,23 @@ func (q *Queries) MarkAsSentOutboxOrderEvent(ctx context.Context, id int32) erro
 	return err
 }
 
-const setOrderStatus = `-- name: SetOrderStatus :exec
+const setOrderStatus = `-- name: SetOrderStatus :execrows
 UPDATE orders
 SET status = $1
 WHERE id = $2
+  AND status = ANY ($3::varchar[])
 `
 
 type SetOrderStatusParams struct {
-	Status string
-	ID     int32
+	Status       string
+	ID           int32
+	FromStatuses []string
 }
 
-func (q *Queries) SetOrderStatus(ctx context.Context, arg SetOrderStatusParams) error {
-	_, err := q.db.Exec(ctx, setOrderStatus, arg.Status, arg.ID)
-	return err
+func (q *Queries) SetOrderStatus(ctx context.Context, arg SetOrderStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setOrderStatus, arg.Status, arg.ID, arg.FromStatuses)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
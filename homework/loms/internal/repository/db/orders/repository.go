@@ -2,21 +2,25 @@ package orders
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"go.opentelemetry.io/otel"
 
 	orderStatus "route256/loms/internal/app/definitions"
 	"route256/loms/internal/domain"
+	"route256/loms/internal/repository/db/transaction"
 	"route256/loms/pkg/prometheus"
 )
 
 type (
 	Storage struct {
-		connRead  *pgx.Conn
-		connWrite *pgx.Conn
+		poolRead  *pgxpool.Pool
+		poolWrite *pgxpool.Pool
 		cmdRead   *Queries
 		cmdWrite  *Queries
 	}
@@ -28,20 +32,22 @@ func (_ OrderNotFoundError) Error() string {
 	return "Order not found"
 }
 
-func NewStorage(connRead, connWrite *pgx.Conn) *Storage {
+func NewStorage(poolRead, poolWrite *pgxpool.Pool) *Storage {
 	return &Storage{
-		connRead:  connRead,
-		connWrite: connWrite,
-		cmdRead:   New(connRead),
-		cmdWrite:  New(connWrite),
+		poolRead:  poolRead,
+		poolWrite: poolWrite,
+		cmdRead:   New(poolRead),
+		cmdWrite:  New(poolWrite),
 	}
 }
 
-func (s *Storage) Create(ctx context.Context, userID int64, items []domain.Item) (int64, error) {
+// Create stores a new order with its items. A non-empty idempotencyKey already used by another order
+// of the same user stores nothing and returns domain.OrderExistsError with that order.
+func (s *Storage) Create(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) (int64, error) {
 	ctx, span := otel.Tracer("loms").Start(ctx, "db_orders_create")
 	defer span.End()
 
-	tx, err := s.connWrite.BeginTx(ctx, pgx.TxOptions{})
+	tx, err := transaction.Begin(ctx, s.poolWrite)
 	if err != nil {
 		return 0, fmt.Errorf("could not begin transaction: %w", err)
 	}
@@ -52,10 +58,17 @@ func (s *Storage) Create(ctx context.Context, userID int64, items []domain.Item)
 
 	startTime := time.Now()
 	orderID, err := s.cmdWrite.WithTx(tx).CreateOrder(ctx, CreateOrderParams{
-		UserID: userID,
-		Status: orderStatus.New,
+		UserID:         userID,
+		Status:         orderStatus.New,
+		IdempotencyKey: pgtype.Text{String: idempotencyKey, Valid: idempotencyKey != ""},
 	})
 
+	// Вставка пропущена: заказ с тем же ключом уже создан, в том числе конкурентным запросом
+	if errors.Is(err, pgx.ErrNoRows) {
+		prometheus.ObserveDBRequestsDurationHistogram(startTime, "insert", "success")
+		return 0, s.orderExistsError(ctx, tx, userID, idempotencyKey)
+	}
+
 	if err != nil {
 		prometheus.ObserveDBRequestsDurationHistogram(startTime, "insert", "error")
 		return 0, err
@@ -104,11 +117,33 @@ func (s *Storage) Create(ctx context.Context, userID int64, items []domain.Item)
 	return int64(orderID), nil
 }
 
+func (s *Storage) orderExistsError(ctx context.Context, tx pgx.Tx, userID int64, idempotencyKey string) error {
+	prometheus.IncDBRequestsTotalCounter("select")
+
+	startTime := time.Now()
+	order, err := s.cmdWrite.WithTx(tx).GetOrderByIdempotencyKey(ctx, GetOrderByIdempotencyKeyParams{
+		UserID:         userID,
+		IdempotencyKey: pgtype.Text{String: idempotencyKey, Valid: true},
+	})
+
+	if err != nil {
+		prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "error")
+		return err
+	}
+
+	prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "success")
+
+	return domain.OrderExistsError{
+		OrderID: int64(order.ID),
+		Status:  order.Status,
+	}
+}
+
 func (s *Storage) SetStatus(ctx context.Context, orderID int64, status string) error {
 	ctx, span := otel.Tracer("loms").Start(ctx, "db_orders_set_status")
 	defer span.End()
 
-	tx, err := s.connWrite.BeginTx(ctx, pgx.TxOptions{})
+	tx, err := transaction.Begin(ctx, s.poolWrite)
 	if err != nil {
 		return fmt.Errorf("could not begin transaction: %w", err)
 	}
@@ -118,9 +153,10 @@ func (s *Storage) SetStatus(ctx context.Context, orderID int64, status string) e
 	prometheus.IncDBRequestsTotalCounter("update")
 
 	startTime := time.Now()
-	err = s.cmdWrite.WithTx(tx).SetOrderStatus(ctx, SetOrderStatusParams{
-		Status: status,
-		ID:     int32(orderID),
+	rows, err := s.cmdWrite.WithTx(tx).SetOrderStatus(ctx, SetOrderStatusParams{
+		Status:       status,
+		ID:           int32(orderID),
+		FromStatuses: domain.PreviousOrderStatuses(status),
 	})
 
 	if err != nil {
@@ -130,6 +166,11 @@ func (s *Storage) SetStatus(ctx context.Context, orderID int64, status string) e
 
 	prometheus.ObserveDBRequestsDurationHistogram(startTime, "update", "success")
 
+	// Статус не изменился: заказа нет или переход из текущего статуса запрещён
+	if rows == 0 {
+		return s.statusTransitionError(ctx, tx, orderID, status)
+	}
+
 	eventType, err := domain.GetEventTypeByOrderStatus(status)
 	if err != nil {
 		return fmt.Errorf("incorrect event type: %w", err)
@@ -158,6 +199,23 @@ func (s *Storage) SetStatus(ctx context.Context, orderID int64, status string) e
 	return nil
 }
 
+func (s *Storage) statusTransitionError(ctx context.Context, tx pgx.Tx, orderID int64, status string) error {
+	order, err := s.cmdWrite.WithTx(tx).GetOrder(ctx, int32(orderID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return OrderNotFoundError{}
+		}
+
+		return fmt.Errorf("error when getting order: %w", err)
+	}
+
+	return domain.OrderStatusTransitionError{
+		OrderID: orderID,
+		From:    order.Status,
+		To:      status,
+	}
+}
+
 func (s *Storage) GetByID(ctx context.Context, orderID int64) (*domain.Order, error) {
 	ctx, span := otel.Tracer("loms").Start(ctx, "db_orders_get_by_id")
 	defer span.End()
@@ -193,6 +251,107 @@ func (s *Storage) GetByID(ctx context.Context, orderID int64) (*domain.Order, er
 	return &order, nil
 }
 
+// GetIDsByStatus returns ids of the orders in the status created before createdBefore, in ascending order of id.
+// Only the ids greater than afterID are returned, so the last id of a page is the cursor of the next one.
+func (s *Storage) GetIDsByStatus(ctx context.Context, status string, createdBefore time.Time, afterID int64, limit int32) ([]int64, error) {
+	ctx, span := otel.Tracer("loms").Start(ctx, "db_orders_get_ids_by_status")
+	defer span.End()
+
+	prometheus.IncDBRequestsTotalCounter("select")
+
+	startTime := time.Now()
+	ids, err := s.cmdRead.GetOrderIDsByStatus(ctx, GetOrderIDsByStatusParams{
+		Status:    status,
+		CreatedAt: pgtype.Timestamptz{Time: createdBefore, Valid: true},
+		ID:        int32(afterID),
+		Limit:     limit,
+	})
+
+	if err != nil {
+		prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "error")
+		return nil, err
+	}
+
+	prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "success")
+
+	orderIDs := make([]int64, len(ids))
+	for i, id := range ids {
+		orderIDs[i] = int64(id)
+	}
+
+	return orderIDs, nil
+}
+
+// List returns the orders matching the filter with their items, the newest first
+func (s *Storage) List(ctx context.Context, filter domain.OrdersFilter) ([]domain.Order, error) {
+	ctx, span := otel.Tracer("loms").Start(ctx, "db_orders_list")
+	defer span.End()
+
+	params := ListOrdersParams{
+		UserID:      pgtype.Int8{Int64: filter.UserID, Valid: filter.UserID != 0},
+		Statuses:    filter.Statuses,
+		CreatedFrom: pgtype.Timestamptz{Time: filter.CreatedFrom, Valid: !filter.CreatedFrom.IsZero()},
+		CreatedTo:   pgtype.Timestamptz{Time: filter.CreatedTo, Valid: !filter.CreatedTo.IsZero()},
+		PageLimit:   filter.Limit,
+	}
+
+	if params.Statuses == nil {
+		params.Statuses = []string{}
+	}
+
+	if filter.After != nil {
+		params.AfterCreatedAt = pgtype.Timestamptz{Time: filter.After.CreatedAt, Valid: true}
+		params.AfterID = pgtype.Int4{Int32: int32(filter.After.ID), Valid: true}
+	}
+
+	prometheus.IncDBRequestsTotalCounter("select")
+
+	startTime := time.Now()
+	ordersResponse, err := s.cmdRead.ListOrders(ctx, params)
+
+	if err != nil {
+		prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "error")
+		return nil, err
+	}
+
+	prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "success")
+
+	if len(ordersResponse) == 0 {
+		return []domain.Order{}, nil
+	}
+
+	orderIDs := make([]int64, len(ordersResponse))
+	for i, order := range ordersResponse {
+		orderIDs[i] = int64(order.ID)
+	}
+
+	prometheus.IncDBRequestsTotalCounter("select")
+
+	startTime = time.Now()
+	itemsResponse, err := s.cmdRead.GetOrderItemsByOrderIDs(ctx, orderIDs)
+
+	if err != nil {
+		prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "error")
+		return nil, err
+	}
+
+	prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "success")
+
+	// Товары всех заказов страницы получаем одним запросом и раскладываем по заказам
+	itemsByOrderID := make(map[int64][]OrderItem, len(ordersResponse))
+	for _, item := range itemsResponse {
+		itemsByOrderID[item.OrderID] = append(itemsByOrderID[item.OrderID], item)
+	}
+
+	orders := make([]domain.Order, len(ordersResponse))
+	for i, orderResponse := range ordersResponse {
+		orders[i] = repackOrder(orderResponse)
+		orders[i].Items = repackItems(itemsByOrderID[int64(orderResponse.ID)])
+	}
+
+	return orders, nil
+}
+
 func (s *Storage) GetUnsentOutboxOrderEvents(ctx context.Context, limit int32) ([]domain.OutboxOrderEvent, error) {
 	ctx, span := otel.Tracer("loms").Start(ctx, "db_orders_get_unsent_outbox_order_event")
 	defer span.End()
@@ -235,9 +394,10 @@ func (s *Storage) MarkAsSentOutboxOrderEvent(ctx context.Context, eventID int64)
 
 func repackOrder(order Order) domain.Order {
 	return domain.Order{
-		ID:     int64(order.ID),
-		UserID: order.UserID,
-		Status: order.Status,
+		ID:        int64(order.ID),
+		UserID:    order.UserID,
+		Status:    order.Status,
+		CreatedAt: order.CreatedAt.Time,
 	}
 }
 
@@ -1,26 +1,26 @@
--- name: GetStocks :many
-SELECT * FROM stocks
-WHERE id = any (sqlc.slice('ids'));
-
--- name: ReserveStock :exec
+-- name: ReserveStock :execrows
 UPDATE stocks
-SET reserved = $1
-WHERE sku = $2;
+SET reserved = reserved + sqlc.arg('count')::integer
+WHERE sku = sqlc.arg('sku')
+  AND total_count - reserved >= sqlc.arg('count')::integer;
 
 -- name: GetStock :one
 SELECT * FROM stocks
 WHERE sku = $1
 LIMIT 1;
 
--- name: RemoveReserveStock :exec
+-- name: ReleaseStock :execrows
 UPDATE stocks
-SET reserved = $1 AND total_count = $2
-WHERE id = $3;
+SET reserved = reserved - sqlc.arg('count')::integer
+WHERE sku = sqlc.arg('sku')
+  AND reserved >= sqlc.arg('count')::integer;
 
--- name: CancelReserveStocks :exec
+-- name: WriteOffStock :execrows
 UPDATE stocks
-SET reserved = $1
-WHERE sku = any (sqlc.slice('skus'));
+SET total_count = total_count - sqlc.arg('count')::integer,
+    reserved    = reserved - sqlc.arg('count')::integer
+WHERE sku = sqlc.arg('sku')
+  AND reserved >= sqlc.arg('count')::integer;
 
 -- name: CreateStock :exec
 INSERT INTO stocks (sku, total_count, reserved)
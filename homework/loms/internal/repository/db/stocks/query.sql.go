@@ -9,22 +9,6 @@ import (
 	"context"
 )
 
-const cancelReserveStocks = `-- name: CancelReserveStocks :exec
-UPDATE stocks
-SET reserved = $1
-WHERE sku = any ($2)
-`
-
-type CancelReserveStocksParams struct {
-	Reserved int32
-	Skus     []int32
-}
-
-func (q *Queries) CancelReserveStocks(ctx context.Context, arg CancelReserveStocksParams) error {
-	_, err := q.db.Exec(ctx, cancelReserveStocks, arg.Reserved, arg.Skus)
-	return err
-}
-
 const createStock = `-- name: CreateStock :exec
 INSERT INTO stocks (sku, total_count, reserved)
 SELECT $1, $2, $3
@@ -60,65 +44,63 @@ func (q *Queries) GetStock(ctx context.Context, sku int32) (Stock, error) {
 	return i, err
 }
 
-const getStocks = `-- name: GetStocks :many
-SELECT id, sku, total_count, reserved FROM stocks
-WHERE id = any ($1)
+const releaseStock = `-- name: ReleaseStock :execrows
+UPDATE stocks
+SET reserved = reserved - $1::integer
+WHERE sku = $2
+  AND reserved >= $1::integer
 `
 
-func (q *Queries) GetStocks(ctx context.Context, ids []int32) ([]Stock, error) {
-	rows, err := q.db.Query(ctx, getStocks, ids)
+type ReleaseStockParams struct {
+	Count int32
+	Sku   int32
+}
+
+func (q *Queries) ReleaseStock(ctx context.Context, arg ReleaseStockParams) (int64, error) {
+	result, err := q.db.Exec(ctx, releaseStock, arg.Count, arg.Sku)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	defer rows.Close()
-	var items []Stock
-	for rows.Next() {
-		var i Stock
-		if err := rows.Scan(
-			&i.ID,
-			&i.Sku,
-			&i.TotalCount,
-			&i.Reserved,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const removeReserveStock = `-- name: RemoveReserveStock :exec
+const reserveStock = `-- name: ReserveStock :execrows
 UPDATE stocks
-SET reserved = $1 AND total_count = $2
-WHERE id = $3
+SET reserved = reserved + $1::integer
+WHERE sku = $2
+  AND total_count - reserved >= $1::integer
 `
 
-type RemoveReserveStockParams struct {
-	Reserved   int32
-	TotalCount int32
-	ID         int32
+type ReserveStockParams struct {
+	Count int32
+	Sku   int32
 }
 
-func (q *Queries) RemoveReserveStock(ctx context.Context, arg RemoveReserveStockParams) error {
-	_, err := q.db.Exec(ctx, removeReserveStock, arg.Reserved, arg.TotalCount, arg.ID)
-	return err
+func (q *Queries) ReserveStock(ctx context.Context, arg ReserveStockParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reserveStock, arg.Count, arg.Sku)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const reserveStock = `-- name: ReserveStock :exec
+const writeOffStock = `-- name: WriteOffStock :execrows
 UPDATE stocks
-SET reserved = $1
+SET total_count = total_count - $1::integer,
+    reserved    = reserved - $1::integer
 WHERE sku = $2
+  AND reserved >= $1::integer
 `
 
-type ReserveStockParams struct {
-	Reserved int32
-	Sku      int32
+type WriteOffStockParams struct {
+	Count int32
+	Sku   int32
 }
 
-func (q *Queries) ReserveStock(ctx context.Context, arg ReserveStockParams) error {
-	_, err := q.db.Exec(ctx, reserveStock, arg.Reserved, arg.Sku)
-	return err
+func (q *Queries) WriteOffStock(ctx context.Context, arg WriteOffStockParams) (int64, error) {
+	result, err := q.db.Exec(ctx, writeOffStock, arg.Count, arg.Sku)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
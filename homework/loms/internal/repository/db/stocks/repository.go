@@ -1,23 +1,28 @@
 package stocks
 
 import (
+	"cmp"
 	"context"
 	_ "embed"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"go.opentelemetry.io/otel"
 
 	"route256/loms/internal/domain"
+	"route256/loms/internal/repository/db/transaction"
 	"route256/loms/pkg/prometheus"
 )
 
 type (
 	Storage struct {
-		connRead  *pgx.Conn
-		connWrite *pgx.Conn
+		poolRead  *pgxpool.Pool
+		poolWrite *pgxpool.Pool
 		cmdRead   *Queries
 		cmdWrite  *Queries
 	}
@@ -38,12 +43,12 @@ func (_ StockNotFoundError) Error() string {
 	return "Stock not found"
 }
 
-func NewStorage(connRead, connWrite *pgx.Conn) *Storage {
+func NewStorage(poolRead, poolWrite *pgxpool.Pool) *Storage {
 	return &Storage{
-		connRead:  connRead,
-		connWrite: connWrite,
-		cmdRead:   New(connRead),
-		cmdWrite:  New(connWrite),
+		poolRead:  poolRead,
+		poolWrite: poolWrite,
+		cmdRead:   New(poolRead),
+		cmdWrite:  New(poolWrite),
 	}
 }
 
@@ -70,89 +75,80 @@ func FillStocks(cmd *Queries) error {
 	return nil
 }
 
+// updateStockFunc applies a guarded update to the stock of the item and returns the number of affected rows
+type updateStockFunc func(ctx context.Context, cmd *Queries, item domain.Item) (int64, error)
+
 func (s *Storage) Reserve(ctx context.Context, items []domain.Item) error {
 	ctx, span := otel.Tracer("loms").Start(ctx, "db_stocks_reserve")
 	defer span.End()
 
-	tx, err := s.connWrite.BeginTx(ctx, pgx.TxOptions{})
-	if err != nil {
-		return fmt.Errorf("error when starting transaction: %w", err)
-	}
-
-	defer tx.Rollback(ctx)
-
-	for _, item := range items {
-		prometheus.IncDBRequestsTotalCounter("update")
-
-		startTime := time.Now()
-		err = s.cmdWrite.WithTx(tx).ReserveStock(ctx, ReserveStockParams{
-			Reserved: int32(item.Count),
-			Sku:      int32(item.SKU),
+	return s.updateStocks(ctx, items, func(ctx context.Context, cmd *Queries, item domain.Item) (int64, error) {
+		return cmd.ReserveStock(ctx, ReserveStockParams{
+			Count: int32(item.Count),
+			Sku:   int32(item.SKU),
 		})
-
-		if err != nil {
-			prometheus.ObserveDBRequestsDurationHistogram(startTime, "update", "error")
-
-			return err
-		}
-
-		prometheus.ObserveDBRequestsDurationHistogram(startTime, "update", "success")
-	}
-
-	err = tx.Commit(ctx)
-	if err != nil {
-		return fmt.Errorf("error when commiting transaction: %w", err)
-	}
-
-	return nil
+	})
 }
 
 func (s *Storage) ReserveRemove(ctx context.Context, items []domain.Item) error {
 	ctx, span := otel.Tracer("loms").Start(ctx, "db_stocks_reserve_remove")
 	defer span.End()
 
-	tx, err := s.connWrite.BeginTx(ctx, pgx.TxOptions{})
-	if err != nil {
-		return fmt.Errorf("error when starting transaction: %w", err)
-	}
-
-	defer tx.Rollback(ctx)
+	return s.updateStocks(ctx, items, func(ctx context.Context, cmd *Queries, item domain.Item) (int64, error) {
+		return cmd.WriteOffStock(ctx, WriteOffStockParams{
+			Count: int32(item.Count),
+			Sku:   int32(item.SKU),
+		})
+	})
+}
 
-	ids := make([]int32, len(items))
-	for i, item := range items {
-		ids[i] = int32(item.ID)
-	}
+func (s *Storage) ReserveCancel(ctx context.Context, items []domain.Item) error {
+	ctx, span := otel.Tracer("loms").Start(ctx, "db_stocks_reserve_cancel")
+	defer span.End()
 
-	prometheus.IncDBRequestsTotalCounter("select")
+	return s.updateStocks(ctx, items, func(ctx context.Context, cmd *Queries, item domain.Item) (int64, error) {
+		return cmd.ReleaseStock(ctx, ReleaseStockParams{
+			Count: int32(item.Count),
+			Sku:   int32(item.SKU),
+		})
+	})
+}
 
-	startTime := time.Now()
-	responseItems, err := s.cmdWrite.GetStocks(ctx, ids)
+// updateStocks applies the update to all items in a single transaction. An update that doesn't pass
+// its guard rolls back the whole transaction with InsufficientStocksError or StockNotFoundError.
+func (s *Storage) updateStocks(ctx context.Context, items []domain.Item, update updateStockFunc) error {
+	// Строки обновляем в порядке SKU, чтобы параллельные заказы не блокировали друг друга крест-накрест
+	sortedItems := slices.Clone(items)
+	slices.SortFunc(sortedItems, func(a, b domain.Item) int {
+		return cmp.Compare(a.SKU, b.SKU)
+	})
 
+	tx, err := transaction.Begin(ctx, s.poolWrite)
 	if err != nil {
-		prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "error")
-
-		return fmt.Errorf("error when getting stocks: %w", err)
+		return fmt.Errorf("error when starting transaction: %w", err)
 	}
 
-	prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "success")
+	defer tx.Rollback(ctx)
+
+	cmd := s.cmdWrite.WithTx(tx)
 
-	for _, responseItem := range responseItems {
+	for _, item := range sortedItems {
 		prometheus.IncDBRequestsTotalCounter("update")
 
-		startTime = time.Now()
-		err = s.cmdWrite.WithTx(tx).RemoveReserveStock(ctx, RemoveReserveStockParams{
-			TotalCount: responseItem.TotalCount - responseItem.Reserved,
-			Reserved:   0,
-			ID:         responseItem.Sku,
-		})
+		startTime := time.Now()
+		rows, err := update(ctx, cmd, item)
 
 		if err != nil {
 			prometheus.ObserveDBRequestsDurationHistogram(startTime, "update", "error")
 
-			return fmt.Errorf("error when removing reserved stock: %w", err)
+			return fmt.Errorf("error when updating stock: %w", err)
 		}
 
 		prometheus.ObserveDBRequestsDurationHistogram(startTime, "update", "success")
+
+		if rows == 0 {
+			return guardError(ctx, cmd, item.SKU)
+		}
 	}
 
 	err = tx.Commit(ctx)
@@ -163,41 +159,18 @@ func (s *Storage) ReserveRemove(ctx context.Context, items []domain.Item) error
 	return nil
 }
 
-func (s *Storage) ReserveCancel(ctx context.Context, items []domain.Item) error {
-	ctx, span := otel.Tracer("loms").Start(ctx, "db_stocks_reserve_cancel")
-	defer span.End()
-
-	tx, err := s.connWrite.BeginTx(ctx, pgx.TxOptions{})
+// guardError tells apart a missing stock from a stock that failed the update guard
+func guardError(ctx context.Context, cmd *Queries, sku uint32) error {
+	_, err := cmd.GetStock(ctx, int32(sku))
 	if err != nil {
-		return fmt.Errorf("error when starting transaction: %w", err)
-	}
-
-	defer tx.Rollback(ctx)
-
-	for _, item := range items {
-		prometheus.IncDBRequestsTotalCounter("update")
-
-		startTime := time.Now()
-		err = s.cmdWrite.WithTx(tx).ReserveStock(ctx, ReserveStockParams{
-			Reserved: 0,
-			Sku:      int32(item.SKU),
-		})
-
-		if err != nil {
-			prometheus.ObserveDBRequestsDurationHistogram(startTime, "update", "error")
-
-			return fmt.Errorf("error when reserving stock: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return StockNotFoundError{}
 		}
 
-		prometheus.ObserveDBRequestsDurationHistogram(startTime, "update", "success")
+		return fmt.Errorf("error when getting stock: %w", err)
 	}
 
-	err = tx.Commit(ctx)
-	if err != nil {
-		return fmt.Errorf("error when commiting transaction: %w", err)
-	}
-
-	return nil
+	return domain.InsufficientStocksError{SKU: sku}
 }
 
 func (s *Storage) GetBySKU(ctx context.Context, sku uint32) (*int64, error) {
@@ -216,12 +189,7 @@ func (s *Storage) GetBySKU(ctx context.Context, sku uint32) (*int64, error) {
 
 	prometheus.ObserveDBRequestsDurationHistogram(startTime, "select", "success")
 
-	if stock.Reserved > 0 {
-		count := int64(0)
-		return &count, nil
-	}
-
-	stockCount := int64(stock.TotalCount)
+	stockCount := int64(stock.TotalCount - stock.Reserved)
 
 	return &stockCount, nil
 }
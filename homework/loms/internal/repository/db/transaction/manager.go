@@ -0,0 +1,56 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type (
+	Manager struct {
+		pool *pgxpool.Pool
+	}
+
+	txKey struct{}
+)
+
+func NewManager(pool *pgxpool.Pool) *Manager {
+	return &Manager{
+		pool: pool,
+	}
+}
+
+// RunInTx runs fn in a single transaction, the repositories called with the ctx passed to fn
+// join it instead of starting their own. The transaction is committed if fn returns nil.
+func (m *Manager) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := Begin(ctx, m.pool)
+	if err != nil {
+		return fmt.Errorf("could not begin transaction: %w", err)
+	}
+
+	defer tx.Rollback(ctx)
+
+	err = fn(context.WithValue(ctx, txKey{}, tx))
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Begin starts a transaction on a connection of the pool or, if ctx already carries a transaction,
+// a nested one on a savepoint of its connection. Rollback of the nested transaction undoes only its own changes.
+func Begin(ctx context.Context, pool *pgxpool.Pool) (pgx.Tx, error) {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.Begin(ctx)
+	}
+
+	return pool.BeginTx(ctx, pgx.TxOptions{})
+}
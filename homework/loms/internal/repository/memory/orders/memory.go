@@ -43,13 +43,27 @@ func (m *MemoryStorage) Create(_ context.Context, userID int64, items []domain.I
 	return newOrderID
 }
 
-func (m *MemoryStorage) SetStatus(_ context.Context, orderID int64, status string) {
+func (m *MemoryStorage) SetStatus(_ context.Context, orderID int64, status string) error {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
-	order := m.orders[orderID]
+	order, ok := m.orders[orderID]
+	if !ok {
+		return OrderNotFoundError{}
+	}
+
+	if !domain.CanChangeOrderStatus(order.Status, status) {
+		return domain.OrderStatusTransitionError{
+			OrderID: orderID,
+			From:    order.Status,
+			To:      status,
+		}
+	}
+
 	order.Status = status
 	m.orders[orderID] = order
+
+	return nil
 }
 
 func (m *MemoryStorage) GetByID(_ context.Context, orderID int64) (*domain.Order, error) {
@@ -56,37 +56,40 @@ type Stock struct {
 }
 
 func (m *MemoryStorage) Reserve(_ context.Context, items []domain.Item) error {
-	m.mtx.Lock()
-	defer m.mtx.Unlock()
-
-	for _, item := range items {
-		if _, ok := m.stocks[item.SKU]; !ok {
-			return StockNotFoundError{}
+	return m.update(items, func(stock *domain.Stock, count int64) bool {
+		if stock.TotalCount-stock.Reserved < count {
+			return false
 		}
 
-		m.stocks[item.SKU].Reserved = int64(item.Count)
-	}
+		stock.Reserved += count
 
-	return nil
+		return true
+	})
 }
 
-func (m *MemoryStorage) ReserveRemove(_ context.Context, items []domain.Item) {
-	m.mtx.Lock()
-	defer m.mtx.Unlock()
+func (m *MemoryStorage) ReserveRemove(_ context.Context, items []domain.Item) error {
+	return m.update(items, func(stock *domain.Stock, count int64) bool {
+		if stock.Reserved < count {
+			return false
+		}
 
-	for _, item := range items {
-		m.stocks[item.SKU].TotalCount -= m.stocks[item.SKU].Reserved
-		m.stocks[item.SKU].Reserved = 0
-	}
+		stock.TotalCount -= count
+		stock.Reserved -= count
+
+		return true
+	})
 }
 
-func (m *MemoryStorage) ReserveCancel(_ context.Context, items []domain.Item) {
-	m.mtx.Lock()
-	defer m.mtx.Unlock()
+func (m *MemoryStorage) ReserveCancel(_ context.Context, items []domain.Item) error {
+	return m.update(items, func(stock *domain.Stock, count int64) bool {
+		if stock.Reserved < count {
+			return false
+		}
 
-	for _, item := range items {
-		m.stocks[item.SKU].Reserved = 0
-	}
+		stock.Reserved -= count
+
+		return true
+	})
 }
 
 func (m *MemoryStorage) GetBySKU(_ context.Context, sku uint32) (*int64, error) {
@@ -98,10 +101,40 @@ func (m *MemoryStorage) GetBySKU(_ context.Context, sku uint32) (*int64, error)
 		return nil, StockNotFoundError{}
 	}
 
-	if stock.Reserved > 0 {
-		count := int64(0)
-		return &count, nil
+	count := stock.TotalCount - stock.Reserved
+
+	return &count, nil
+}
+
+// update applies the guarded update to all items, nothing is changed if any of the guards fails
+func (m *MemoryStorage) update(items []domain.Item, apply func(stock *domain.Stock, count int64) bool) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	// Изменения применяем к копиям, чтобы при ошибке откатить весь заказ
+	updated := make(map[uint32]domain.Stock, len(items))
+
+	for _, item := range items {
+		stock, ok := updated[item.SKU]
+		if !ok {
+			current, found := m.stocks[item.SKU]
+			if !found {
+				return StockNotFoundError{}
+			}
+
+			stock = *current
+		}
+
+		if !apply(&stock, int64(item.Count)) {
+			return domain.InsufficientStocksError{SKU: item.SKU}
+		}
+
+		updated[item.SKU] = stock
+	}
+
+	for sku, stock := range updated {
+		*m.stocks[sku] = stock
 	}
 
-	return &stock.TotalCount, nil
+	return nil
 }
@@ -0,0 +1,103 @@
+package stocks
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"route256/loms/internal/domain"
+)
+
+func TestReserveConcurrentOrdersOnOneSKU(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	storage, err := NewMemoryStorage()
+	require.NoError(t, err)
+
+	const (
+		sku        = 1076963
+		totalCount = 30
+		orders     = 50
+	)
+
+	errs := make([]error, orders)
+
+	wg := sync.WaitGroup{}
+	wg.Add(orders)
+
+	for i := 0; i < orders; i++ {
+		go func() {
+			defer wg.Done()
+
+			errs[i] = storage.Reserve(ctx, []domain.Item{{SKU: sku, Count: 1}})
+		}()
+	}
+
+	wg.Wait()
+
+	reserved := 0
+	for _, err := range errs {
+		if err == nil {
+			reserved++
+			continue
+		}
+
+		require.ErrorIs(t, err, domain.InsufficientStocksError{SKU: sku})
+		require.EqualError(t, err, "insufficient stocks for sku 1076963")
+	}
+
+	require.Equal(t, totalCount, reserved)
+
+	available, err := storage.GetBySKU(ctx, sku)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), *available)
+}
+
+func TestReserveIsAtomic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	storage, err := NewMemoryStorage()
+	require.NoError(t, err)
+
+	err = storage.Reserve(ctx, []domain.Item{
+		{SKU: 1076963, Count: 5},
+		{SKU: 1148162, Count: 19},
+	})
+	require.ErrorIs(t, err, domain.InsufficientStocksError{})
+
+	available, err := storage.GetBySKU(ctx, 1076963)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), *available)
+
+	err = storage.Reserve(ctx, []domain.Item{{SKU: 1, Count: 1}})
+	require.ErrorIs(t, err, StockNotFoundError{})
+}
+
+func TestReserveRemoveAndCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	storage, err := NewMemoryStorage()
+	require.NoError(t, err)
+
+	items := []domain.Item{{SKU: 1148162, Count: 10}}
+
+	require.NoError(t, storage.Reserve(ctx, items))
+	require.NoError(t, storage.ReserveCancel(ctx, items))
+	require.ErrorIs(t, storage.ReserveCancel(ctx, items), domain.InsufficientStocksError{SKU: 1148162})
+
+	require.NoError(t, storage.Reserve(ctx, items))
+	require.NoError(t, storage.ReserveRemove(ctx, items))
+	require.ErrorIs(t, storage.ReserveRemove(ctx, items), domain.InsufficientStocksError{SKU: 1148162})
+
+	available, err := storage.GetBySKU(ctx, 1148162)
+	require.NoError(t, err)
+	require.Equal(t, int64(8), *available)
+}
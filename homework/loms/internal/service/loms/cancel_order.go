@@ -30,12 +30,16 @@ func (s *Service) CancelOrder(ctx context.Context, orderID int64) error {
 		return fmt.Errorf("%w, %w", CancelOrderError{}, err)
 	}
 
-	err = s.stocksRepo.ReserveCancel(ctx, order.Items)
-	if err != nil {
-		return fmt.Errorf("%w, %w", CancelOrderError{}, err)
-	}
+	// Переход проверяет только условный SetStatus в транзакции: статус из GetByID мог устареть
+	err = s.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		err := s.ordersRepo.SetStatus(ctx, orderID, orderStatus.Cancelled)
+		if err != nil {
+			return err
+		}
+
+		return s.stocksRepo.ReserveCancel(ctx, order.Items)
+	})
 
-	err = s.ordersRepo.SetStatus(ctx, orderID, orderStatus.Cancelled)
 	if err != nil {
 		return fmt.Errorf("%w, %w", CancelOrderError{}, err)
 	}
@@ -20,6 +20,7 @@ func TestCancelOrderWithPrepare(t *testing.T) {
 		fields struct {
 			ordersRepMock *mock.OrdersRepositoryMock
 			stocksRepMock *mock.StocksRepositoryMock
+			txManagerMock *mock.TxManagerMock
 		}
 
 		data struct {
@@ -47,7 +48,7 @@ func TestCancelOrderWithPrepare(t *testing.T) {
 			f.ordersRepMock.SetStatusMock.ExpectOrderIDParam2(123).ExpectStatusParam3(orderStatus.Cancelled).Return(nil)
 
 			f.stocksRepMock.ReserveCancelMock.Times(1)
-			f.ordersRepMock.SetStatusMock.Times(1)
+			f.ordersRepMock.SetStatusMock.Times(4)
 		},
 		wantErr: nil,
 	}, {
@@ -57,15 +58,66 @@ func TestCancelOrderWithPrepare(t *testing.T) {
 			f.ordersRepMock.GetByIDMock.ExpectOrderIDParam2(123).Return(nil, orders.OrderNotFoundError{})
 		},
 		wantErr: orders.OrderNotFoundError{},
+	}, {
+		name:    "Payed order",
+		orderID: 124,
+		prepare: func(f *fields) {
+			f.ordersRepMock.GetByIDMock.ExpectOrderIDParam2(124).Return(&domain.Order{
+				Status: orderStatus.Payed,
+				UserID: 321,
+			}, nil)
+			// Статус проверяет условный SetStatus в транзакции
+			f.ordersRepMock.SetStatusMock.ExpectOrderIDParam2(124).ExpectStatusParam3(orderStatus.Cancelled).Return(domain.OrderStatusTransitionError{
+				OrderID: 124,
+				From:    orderStatus.Payed,
+				To:      orderStatus.Cancelled,
+			})
+		},
+		wantErr: domain.OrderStatusTransitionError{},
+	}, {
+		name:    "Failed order",
+		orderID: 124,
+		prepare: func(f *fields) {
+			f.ordersRepMock.GetByIDMock.ExpectOrderIDParam2(124).Return(&domain.Order{
+				Status: orderStatus.Failed,
+				UserID: 321,
+			}, nil)
+			f.ordersRepMock.SetStatusMock.ExpectOrderIDParam2(124).ExpectStatusParam3(orderStatus.Cancelled).Return(domain.OrderStatusTransitionError{
+				OrderID: 124,
+				From:    orderStatus.Failed,
+				To:      orderStatus.Cancelled,
+			})
+		},
+		wantErr: domain.OrderStatusTransitionError{},
+	}, {
+		name:    "Status changed after read",
+		orderID: 125,
+		prepare: func(f *fields) {
+			f.ordersRepMock.GetByIDMock.ExpectOrderIDParam2(125).Return(&domain.Order{
+				Status: orderStatus.AwaitingPayment,
+				UserID: 321,
+			}, nil)
+			f.ordersRepMock.SetStatusMock.ExpectOrderIDParam2(125).ExpectStatusParam3(orderStatus.Cancelled).Return(domain.OrderStatusTransitionError{
+				OrderID: 125,
+				From:    orderStatus.Payed,
+				To:      orderStatus.Cancelled,
+			})
+		},
+		wantErr: domain.OrderStatusTransitionError{},
 	}}
 
 	ctrl := minimock.NewController(t)
 	fieldsForTableTest := fields{
 		ordersRepMock: mock.NewOrdersRepositoryMock(ctrl),
 		stocksRepMock: mock.NewStocksRepositoryMock(ctrl),
+		txManagerMock: mock.NewTxManagerMock(ctrl),
 	}
 
-	handler := NewService(fieldsForTableTest.ordersRepMock, fieldsForTableTest.stocksRepMock)
+	fieldsForTableTest.txManagerMock.RunInTxMock.Set(func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	handler := NewService(fieldsForTableTest.ordersRepMock, fieldsForTableTest.stocksRepMock, fieldsForTableTest.txManagerMock)
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2,6 +2,7 @@ package lomsusecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"go.opentelemetry.io/otel"
@@ -17,31 +18,67 @@ func (_ CreateOrderError) Error() string {
 	return "Error by creating order: "
 }
 
-func (s *Service) CreateOrder(ctx context.Context, userID int64, items []domain.Item) (*int64, error) {
+// OrderFailedError is returned for a repeated request whose order with the same idempotency key has failed
+type OrderFailedError struct {
+	OrderID int64
+}
+
+func (e OrderFailedError) Error() string {
+	return fmt.Sprintf("order %d with the same idempotency key has failed", e.OrderID)
+}
+
+// CreateOrder creates an order and reserves its items. A request repeating a non-empty idempotencyKey
+// returns the order created by the first one and reserves nothing.
+func (s *Service) CreateOrder(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) (*int64, error) {
 	ctx, span := otel.Tracer("loms").Start(ctx, "service_create_order")
 	defer span.End()
 
-	orderID, err := s.ordersRepo.Create(ctx, userID, items)
+	var (
+		orderID    int64
+		reserveErr error
+	)
 
-	if err != nil {
-		return nil, fmt.Errorf("%w, %w", CreateOrderError{}, err)
-	}
-
-	err = s.stocksRepo.Reserve(ctx, items)
+	// Заказ, резерв, статус и события outbox фиксируются одной транзакцией,
+	// неудачный резерв откатывается отдельно, а заказ сохраняется со статусом failed
+	err := s.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
 
-	if err != nil {
-		err = s.ordersRepo.SetStatus(ctx, orderID, orderStatus.Failed)
+		orderID, err = s.ordersRepo.Create(ctx, userID, items, idempotencyKey)
 		if err != nil {
-			return nil, fmt.Errorf("%w, %w", CreateOrderError{}, err)
+			return err
 		}
 
-		return nil, stocks.StockNotFoundError{}
+		status := orderStatus.AwaitingPayment
+
+		reserveErr = s.stocksRepo.Reserve(ctx, items)
+		if reserveErr != nil {
+			status = orderStatus.Failed
+		}
+
+		return s.ordersRepo.SetStatus(ctx, orderID, status)
+	})
+
+	var existsErr domain.OrderExistsError
+	if errors.As(err, &existsErr) {
+		if existsErr.Status == orderStatus.Failed {
+			return nil, OrderFailedError{OrderID: existsErr.OrderID}
+		}
+
+		return &existsErr.OrderID, nil
 	}
 
-	err = s.ordersRepo.SetStatus(ctx, orderID, orderStatus.AwaitingPayment)
 	if err != nil {
 		return nil, fmt.Errorf("%w, %w", CreateOrderError{}, err)
 	}
 
+	if reserveErr != nil {
+		var insufficientErr domain.InsufficientStocksError
+		if errors.As(reserveErr, &insufficientErr) {
+			return nil, insufficientErr
+		}
+
+		return nil, stocks.StockNotFoundError{}
+	}
+
 	return &orderID, nil
 }
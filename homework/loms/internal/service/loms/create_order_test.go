@@ -2,6 +2,7 @@ package lomsusecase
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/gojuno/minimock/v3"
@@ -13,22 +14,29 @@ import (
 	"route256/loms/internal/service/loms/mock"
 )
 
+type txKey struct{}
+
 func TestCreateOrderWithPrepare(t *testing.T) {
 	ctx := context.Background()
 
+	// Репозитории должны получать контекст транзакции, открытой менеджером
+	txCtx := context.WithValue(ctx, txKey{}, "tx")
+
 	type (
 		fields struct {
 			ordersRepMock *mock.OrdersRepositoryMock
 			stocksRepMock *mock.StocksRepositoryMock
+			txManagerMock *mock.TxManagerMock
 		}
 
 		data struct {
-			name       string
-			userID     int64
-			orderID    int64
-			prepare    func(f *fields)
-			orderItems []domain.Item
-			wantErr    error
+			name           string
+			userID         int64
+			orderID        int64
+			idempotencyKey string
+			prepare        func(f *fields)
+			orderItems     []domain.Item
+			wantErr        error
 		}
 	)
 
@@ -45,9 +53,9 @@ func TestCreateOrderWithPrepare(t *testing.T) {
 				SKU:   872821,
 				Count: 8,
 			}}
-			f.ordersRepMock.CreateMock.ExpectUserIDParam2(123).ExpectItemsParam3(orderItems).Return(721, nil)
-			f.stocksRepMock.ReserveMock.ExpectItemsParam2(orderItems).Return(nil)
-			f.ordersRepMock.SetStatusMock.ExpectOrderIDParam2(721).ExpectStatusParam3(orderStatus.AwaitingPayment).Return(nil)
+			f.ordersRepMock.CreateMock.Expect(txCtx, 123, orderItems, "").Return(721, nil)
+			f.stocksRepMock.ReserveMock.Expect(txCtx, orderItems).Return(nil)
+			f.ordersRepMock.SetStatusMock.Expect(txCtx, 721, orderStatus.AwaitingPayment).Return(nil)
 		},
 		wantErr: nil,
 	}, {
@@ -63,26 +71,112 @@ func TestCreateOrderWithPrepare(t *testing.T) {
 				SKU:   872821,
 				Count: 8,
 			}}
-			f.ordersRepMock.CreateMock.ExpectUserIDParam2(123).ExpectItemsParam3(orderItems).Return(721, nil)
-			f.stocksRepMock.ReserveMock.ExpectItemsParam2(orderItems).Return(stocks.StockNotFoundError{})
-			f.ordersRepMock.SetStatusMock.ExpectOrderIDParam2(721).ExpectStatusParam3(orderStatus.Failed).Return(nil)
+			f.ordersRepMock.CreateMock.Expect(txCtx, 123, orderItems, "").Return(721, nil)
+			f.stocksRepMock.ReserveMock.Expect(txCtx, orderItems).Return(stocks.StockNotFoundError{})
+			f.ordersRepMock.SetStatusMock.Expect(txCtx, 721, orderStatus.Failed).Return(nil)
 		},
 		wantErr: stocks.StockNotFoundError{},
+	}, {
+		name:    "Insufficient stocks",
+		userID:  123,
+		orderID: 722,
+		orderItems: []domain.Item{{
+			SKU:   872821,
+			Count: 800,
+		}},
+		prepare: func(f *fields) {
+			orderItems := []domain.Item{{
+				SKU:   872821,
+				Count: 800,
+			}}
+			f.ordersRepMock.CreateMock.Expect(txCtx, 123, orderItems, "").Return(722, nil)
+			f.stocksRepMock.ReserveMock.Expect(txCtx, orderItems).Return(fmt.Errorf("error when updating stock: %w", domain.InsufficientStocksError{SKU: 872821}))
+			f.ordersRepMock.SetStatusMock.Expect(txCtx, 722, orderStatus.Failed).Return(nil)
+		},
+		wantErr: domain.InsufficientStocksError{SKU: 872821},
+	}, {
+		name:    "Set status failed",
+		userID:  123,
+		orderID: 723,
+		orderItems: []domain.Item{{
+			SKU:   872821,
+			Count: 8,
+		}},
+		prepare: func(f *fields) {
+			orderItems := []domain.Item{{
+				SKU:   872821,
+				Count: 8,
+			}}
+			f.ordersRepMock.CreateMock.Expect(txCtx, 123, orderItems, "").Return(723, nil)
+			f.stocksRepMock.ReserveMock.Expect(txCtx, orderItems).Return(nil)
+			f.ordersRepMock.SetStatusMock.Expect(txCtx, 723, orderStatus.AwaitingPayment).Return(fmt.Errorf("connection lost"))
+		},
+		wantErr: CreateOrderError{},
+	}, {
+		name:           "Repeated idempotency key",
+		userID:         123,
+		orderID:        724,
+		idempotencyKey: "key-724",
+		orderItems: []domain.Item{{
+			SKU:   872821,
+			Count: 8,
+		}},
+		prepare: func(f *fields) {
+			orderItems := []domain.Item{{
+				SKU:   872821,
+				Count: 8,
+			}}
+			// Повтор возвращает уже созданный заказ и ничего не резервирует
+			f.ordersRepMock.CreateMock.Expect(txCtx, 123, orderItems, "key-724").Return(0, domain.OrderExistsError{
+				OrderID: 724,
+				Status:  orderStatus.AwaitingPayment,
+			})
+		},
+		wantErr: nil,
+	}, {
+		name:           "Repeated idempotency key of failed order",
+		userID:         123,
+		orderID:        725,
+		idempotencyKey: "key-725",
+		orderItems: []domain.Item{{
+			SKU:   872821,
+			Count: 800,
+		}},
+		prepare: func(f *fields) {
+			orderItems := []domain.Item{{
+				SKU:   872821,
+				Count: 800,
+			}}
+			f.ordersRepMock.CreateMock.Expect(txCtx, 123, orderItems, "key-725").Return(0, domain.OrderExistsError{
+				OrderID: 725,
+				Status:  orderStatus.Failed,
+			})
+		},
+		wantErr: OrderFailedError{OrderID: 725},
 	}}
 
 	ctrl := minimock.NewController(t)
 	fieldsForTableTest := fields{
 		ordersRepMock: mock.NewOrdersRepositoryMock(ctrl),
 		stocksRepMock: mock.NewStocksRepositoryMock(ctrl),
+		txManagerMock: mock.NewTxManagerMock(ctrl),
 	}
 
-	handler := NewService(fieldsForTableTest.ordersRepMock, fieldsForTableTest.stocksRepMock)
+	fieldsForTableTest.txManagerMock.RunInTxMock.Set(func(_ context.Context, fn func(ctx context.Context) error) error {
+		return fn(txCtx)
+	})
+
+	handler := NewService(fieldsForTableTest.ordersRepMock, fieldsForTableTest.stocksRepMock, fieldsForTableTest.txManagerMock)
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.prepare(&fieldsForTableTest)
-			_, err := handler.CreateOrder(ctx, tt.userID, tt.orderItems)
+			orderID, err := handler.CreateOrder(ctx, tt.userID, tt.orderItems, tt.idempotencyKey)
 			require.ErrorIs(t, err, tt.wantErr)
+
+			if tt.wantErr == nil {
+				require.Equal(t, tt.orderID, *orderID)
+			}
 		})
 	}
 }
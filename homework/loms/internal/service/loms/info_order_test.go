@@ -20,6 +20,7 @@ func TestInfoOrdersWithPrepare(t *testing.T) {
 		fields struct {
 			ordersRepMock *mock.OrdersRepositoryMock
 			stocksRepMock *mock.StocksRepositoryMock
+			txManagerMock *mock.TxManagerMock
 		}
 
 		data struct {
@@ -58,9 +59,10 @@ func TestInfoOrdersWithPrepare(t *testing.T) {
 	fieldsForTableTest := fields{
 		ordersRepMock: mock.NewOrdersRepositoryMock(ctrl),
 		stocksRepMock: mock.NewStocksRepositoryMock(ctrl),
+		txManagerMock: mock.NewTxManagerMock(ctrl),
 	}
 
-	handler := NewService(fieldsForTableTest.ordersRepMock, fieldsForTableTest.stocksRepMock)
+	handler := NewService(fieldsForTableTest.ordersRepMock, fieldsForTableTest.stocksRepMock, fieldsForTableTest.txManagerMock)
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
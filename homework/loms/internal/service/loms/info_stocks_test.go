@@ -18,6 +18,7 @@ func TestInfoStocksWithPrepare(t *testing.T) {
 		fields struct {
 			ordersRepMock *mock.OrdersRepositoryMock
 			stocksRepMock *mock.StocksRepositoryMock
+			txManagerMock *mock.TxManagerMock
 		}
 
 		data struct {
@@ -61,9 +62,10 @@ func TestInfoStocksWithPrepare(t *testing.T) {
 	fieldsForTableTest := fields{
 		ordersRepMock: mock.NewOrdersRepositoryMock(ctrl),
 		stocksRepMock: mock.NewStocksRepositoryMock(ctrl),
+		txManagerMock: mock.NewTxManagerMock(ctrl),
 	}
 
-	handler := NewService(fieldsForTableTest.ordersRepMock, fieldsForTableTest.stocksRepMock)
+	handler := NewService(fieldsForTableTest.ordersRepMock, fieldsForTableTest.stocksRepMock, fieldsForTableTest.txManagerMock)
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,92 @@
+package lomsusecase
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+
+	"route256/loms/internal/domain"
+)
+
+const defaultOrdersPageSize = 50
+
+type ListOrdersError struct{}
+
+func (_ ListOrdersError) Error() string {
+	return "Error by listing orders: "
+}
+
+// ListOrders returns a page of the orders matching the filter, the newest first, and the token of the next page.
+// The next page token is empty on the last page
+func (s *Service) ListOrders(ctx context.Context, filter domain.OrdersFilter, pageToken string, pageSize uint32) ([]domain.Order, string, error) {
+	ctx, span := otel.Tracer("loms").Start(ctx, "service_list_orders")
+	defer span.End()
+
+	if pageSize == 0 {
+		pageSize = defaultOrdersPageSize
+	}
+
+	if pageToken != "" {
+		cursor, err := decodePageToken(pageToken)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %w", domain.InvalidPageTokenError{}, err)
+		}
+
+		filter.After = cursor
+	}
+
+	// Запрашиваем на один заказ больше, чтобы понять, есть ли следующая страница
+	filter.Limit = int32(pageSize) + 1
+
+	orders, err := s.ordersRepo.List(ctx, filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w, %w", ListOrdersError{}, err)
+	}
+
+	if len(orders) <= int(pageSize) {
+		return orders, "", nil
+	}
+
+	orders = orders[:pageSize]
+	last := orders[len(orders)-1]
+
+	return orders, encodePageToken(domain.OrderCursor{CreatedAt: last.CreatedAt, ID: last.ID}), nil
+}
+
+func encodePageToken(cursor domain.OrderCursor) string {
+	token := fmt.Sprintf("%d:%d", cursor.CreatedAt.UnixNano(), cursor.ID)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(token))
+}
+
+func decodePageToken(pageToken string) (*domain.OrderCursor, error) {
+	token, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, id, ok := strings.Cut(string(token), ":")
+	if !ok {
+		return nil, fmt.Errorf("malformed token %q", token)
+	}
+
+	createdAtNano, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.OrderCursor{
+		CreatedAt: time.Unix(0, createdAtNano),
+		ID:        orderID,
+	}, nil
+}
@@ -0,0 +1,149 @@
+package lomsusecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	orderStatus "route256/loms/internal/app/definitions"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/service/loms/mock"
+)
+
+func TestListOrdersWithPrepare(t *testing.T) {
+	ctx := context.Background()
+
+	createdAt := time.Unix(1700000000, 0)
+
+	listOrders := []domain.Order{{
+		ID:        3,
+		Status:    orderStatus.AwaitingPayment,
+		UserID:    321,
+		CreatedAt: createdAt.Add(2 * time.Second),
+	}, {
+		ID:        2,
+		Status:    orderStatus.Payed,
+		UserID:    321,
+		CreatedAt: createdAt.Add(time.Second),
+	}, {
+		ID:        1,
+		Status:    orderStatus.Cancelled,
+		UserID:    321,
+		CreatedAt: createdAt,
+	}}
+
+	cursor := domain.OrderCursor{
+		CreatedAt: createdAt.Add(time.Second),
+		ID:        2,
+	}
+
+	type (
+		fields struct {
+			ordersRepMock *mock.OrdersRepositoryMock
+			stocksRepMock *mock.StocksRepositoryMock
+			txManagerMock *mock.TxManagerMock
+		}
+
+		data struct {
+			name          string
+			filter        domain.OrdersFilter
+			pageToken     string
+			pageSize      uint32
+			prepare       func(f *fields)
+			wantOrders    []domain.Order
+			wantNextToken string
+			wantErr       error
+		}
+	)
+
+	testData := []data{{
+		name:     "First page",
+		filter:   domain.OrdersFilter{UserID: 321},
+		pageSize: 2,
+		prepare: func(f *fields) {
+			f.ordersRepMock.ListMock.ExpectFilterParam2(domain.OrdersFilter{
+				UserID: 321,
+				Limit:  3,
+			}).Return(listOrders, nil)
+		},
+		wantOrders:    listOrders[:2],
+		wantNextToken: encodePageToken(cursor),
+		wantErr:       nil,
+	}, {
+		name:      "Last page",
+		filter:    domain.OrdersFilter{UserID: 321},
+		pageToken: encodePageToken(cursor),
+		pageSize:  2,
+		prepare: func(f *fields) {
+			f.ordersRepMock.ListMock.ExpectFilterParam2(domain.OrdersFilter{
+				UserID: 321,
+				After:  &cursor,
+				Limit:  3,
+			}).Return(listOrders[2:], nil)
+		},
+		wantOrders:    listOrders[2:],
+		wantNextToken: "",
+		wantErr:       nil,
+	}, {
+		name:   "Default page size",
+		filter: domain.OrdersFilter{Statuses: []string{orderStatus.Payed}},
+		prepare: func(f *fields) {
+			f.ordersRepMock.ListMock.ExpectFilterParam2(domain.OrdersFilter{
+				Statuses: []string{orderStatus.Payed},
+				Limit:    defaultOrdersPageSize + 1,
+			}).Return(listOrders[1:2], nil)
+		},
+		wantOrders:    listOrders[1:2],
+		wantNextToken: "",
+		wantErr:       nil,
+	}, {
+		name:      "Invalid page token",
+		pageToken: "not a token",
+		prepare:   func(f *fields) {},
+		wantErr:   domain.InvalidPageTokenError{},
+	}, {
+		name:     "Repository error",
+		pageSize: 2,
+		prepare: func(f *fields) {
+			f.ordersRepMock.ListMock.ExpectFilterParam2(domain.OrdersFilter{
+				Limit: 3,
+			}).Return(nil, fmt.Errorf("test error"))
+		},
+		wantErr: ListOrdersError{},
+	}}
+
+	ctrl := minimock.NewController(t)
+	fieldsForTableTest := fields{
+		ordersRepMock: mock.NewOrdersRepositoryMock(ctrl),
+		stocksRepMock: mock.NewStocksRepositoryMock(ctrl),
+		txManagerMock: mock.NewTxManagerMock(ctrl),
+	}
+
+	handler := NewService(fieldsForTableTest.ordersRepMock, fieldsForTableTest.stocksRepMock, fieldsForTableTest.txManagerMock)
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare(&fieldsForTableTest)
+			orders, nextToken, err := handler.ListOrders(ctx, tt.filter, tt.pageToken, tt.pageSize)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.wantOrders, orders)
+			require.Equal(t, tt.wantNextToken, nextToken)
+		})
+	}
+}
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	cursor := domain.OrderCursor{
+		CreatedAt: time.Unix(1700000000, 123456789),
+		ID:        42,
+	}
+
+	decoded, err := decodePageToken(encodePageToken(cursor))
+	require.NoError(t, err)
+	require.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt))
+	require.Equal(t, cursor.ID, decoded.ID)
+}
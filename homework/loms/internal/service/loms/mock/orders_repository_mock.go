@@ -19,8 +19,8 @@ type OrdersRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcCreate          func(ctx context.Context, userID int64, items []domain.Item) (i1 int64, err error)
-	inspectFuncCreate   func(ctx context.Context, userID int64, items []domain.Item)
+	funcCreate          func(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) (i1 int64, err error)
+	inspectFuncCreate   func(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string)
 	afterCreateCounter  uint64
 	beforeCreateCounter uint64
 	CreateMock          mOrdersRepositoryMockCreate
@@ -31,6 +31,12 @@ type OrdersRepositoryMock struct {
 	beforeGetByIDCounter uint64
 	GetByIDMock          mOrdersRepositoryMockGetByID
 
+	funcList          func(ctx context.Context, filter domain.OrdersFilter) (oa1 []domain.Order, err error)
+	inspectFuncList   func(ctx context.Context, filter domain.OrdersFilter)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mOrdersRepositoryMockList
+
 	funcSetStatus          func(ctx context.Context, orderID int64, status string) (err error)
 	inspectFuncSetStatus   func(ctx context.Context, orderID int64, status string)
 	afterSetStatusCounter  uint64
@@ -52,6 +58,9 @@ func NewOrdersRepositoryMock(t minimock.Tester) *OrdersRepositoryMock {
 	m.GetByIDMock = mOrdersRepositoryMockGetByID{mock: m}
 	m.GetByIDMock.callArgs = []*OrdersRepositoryMockGetByIDParams{}
 
+	m.ListMock = mOrdersRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*OrdersRepositoryMockListParams{}
+
 	m.SetStatusMock = mOrdersRepositoryMockSetStatus{mock: m}
 	m.SetStatusMock.callArgs = []*OrdersRepositoryMockSetStatusParams{}
 
@@ -83,16 +92,18 @@ type OrdersRepositoryMockCreateExpectation struct {
 
 // OrdersRepositoryMockCreateParams contains parameters of the OrdersRepository.Create
 type OrdersRepositoryMockCreateParams struct {
-	ctx    context.Context
-	userID int64
-	items  []domain.Item
+	ctx            context.Context
+	userID         int64
+	items          []domain.Item
+	idempotencyKey string
 }
 
 // OrdersRepositoryMockCreateParamPtrs contains pointers to parameters of the OrdersRepository.Create
 type OrdersRepositoryMockCreateParamPtrs struct {
-	ctx    *context.Context
-	userID *int64
-	items  *[]domain.Item
+	ctx            *context.Context
+	userID         *int64
+	items          *[]domain.Item
+	idempotencyKey *string
 }
 
 // OrdersRepositoryMockCreateResults contains results of the OrdersRepository.Create
@@ -112,7 +123,7 @@ func (mmCreate *mOrdersRepositoryMockCreate) Optional() *mOrdersRepositoryMockCr
 }
 
 // Expect sets up expected params for OrdersRepository.Create
-func (mmCreate *mOrdersRepositoryMockCreate) Expect(ctx context.Context, userID int64, items []domain.Item) *mOrdersRepositoryMockCreate {
+func (mmCreate *mOrdersRepositoryMockCreate) Expect(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) *mOrdersRepositoryMockCreate {
 	if mmCreate.mock.funcCreate != nil {
 		mmCreate.mock.t.Fatalf("OrdersRepositoryMock.Create mock is already set by Set")
 	}
@@ -125,7 +136,7 @@ func (mmCreate *mOrdersRepositoryMockCreate) Expect(ctx context.Context, userID
 		mmCreate.mock.t.Fatalf("OrdersRepositoryMock.Create mock is already set by ExpectParams functions")
 	}
 
-	mmCreate.defaultExpectation.params = &OrdersRepositoryMockCreateParams{ctx, userID, items}
+	mmCreate.defaultExpectation.params = &OrdersRepositoryMockCreateParams{ctx, userID, items, idempotencyKey}
 	for _, e := range mmCreate.expectations {
 		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
 			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
@@ -201,8 +212,30 @@ func (mmCreate *mOrdersRepositoryMockCreate) ExpectItemsParam3(items []domain.It
 	return mmCreate
 }
 
+// ExpectIdempotencyKeyParam4 sets up expected param idempotencyKey for OrdersRepository.Create
+func (mmCreate *mOrdersRepositoryMockCreate) ExpectIdempotencyKeyParam4(idempotencyKey string) *mOrdersRepositoryMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("OrdersRepositoryMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &OrdersRepositoryMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("OrdersRepositoryMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &OrdersRepositoryMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.idempotencyKey = &idempotencyKey
+
+	return mmCreate
+}
+
 // Inspect accepts an inspector function that has same arguments as the OrdersRepository.Create
-func (mmCreate *mOrdersRepositoryMockCreate) Inspect(f func(ctx context.Context, userID int64, items []domain.Item)) *mOrdersRepositoryMockCreate {
+func (mmCreate *mOrdersRepositoryMockCreate) Inspect(f func(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string)) *mOrdersRepositoryMockCreate {
 	if mmCreate.mock.inspectFuncCreate != nil {
 		mmCreate.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.Create")
 	}
@@ -226,7 +259,7 @@ func (mmCreate *mOrdersRepositoryMockCreate) Return(i1 int64, err error) *Orders
 }
 
 // Set uses given function f to mock the OrdersRepository.Create method
-func (mmCreate *mOrdersRepositoryMockCreate) Set(f func(ctx context.Context, userID int64, items []domain.Item) (i1 int64, err error)) *OrdersRepositoryMock {
+func (mmCreate *mOrdersRepositoryMockCreate) Set(f func(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) (i1 int64, err error)) *OrdersRepositoryMock {
 	if mmCreate.defaultExpectation != nil {
 		mmCreate.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.Create method")
 	}
@@ -241,14 +274,14 @@ func (mmCreate *mOrdersRepositoryMockCreate) Set(f func(ctx context.Context, use
 
 // When sets expectation for the OrdersRepository.Create which will trigger the result defined by the following
 // Then helper
-func (mmCreate *mOrdersRepositoryMockCreate) When(ctx context.Context, userID int64, items []domain.Item) *OrdersRepositoryMockCreateExpectation {
+func (mmCreate *mOrdersRepositoryMockCreate) When(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) *OrdersRepositoryMockCreateExpectation {
 	if mmCreate.mock.funcCreate != nil {
 		mmCreate.mock.t.Fatalf("OrdersRepositoryMock.Create mock is already set by Set")
 	}
 
 	expectation := &OrdersRepositoryMockCreateExpectation{
 		mock:   mmCreate.mock,
-		params: &OrdersRepositoryMockCreateParams{ctx, userID, items},
+		params: &OrdersRepositoryMockCreateParams{ctx, userID, items, idempotencyKey},
 	}
 	mmCreate.expectations = append(mmCreate.expectations, expectation)
 	return expectation
@@ -281,15 +314,15 @@ func (mmCreate *mOrdersRepositoryMockCreate) invocationsDone() bool {
 }
 
 // Create implements lomsusecase.OrdersRepository
-func (mmCreate *OrdersRepositoryMock) Create(ctx context.Context, userID int64, items []domain.Item) (i1 int64, err error) {
+func (mmCreate *OrdersRepositoryMock) Create(ctx context.Context, userID int64, items []domain.Item, idempotencyKey string) (i1 int64, err error) {
 	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
 	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
 
 	if mmCreate.inspectFuncCreate != nil {
-		mmCreate.inspectFuncCreate(ctx, userID, items)
+		mmCreate.inspectFuncCreate(ctx, userID, items, idempotencyKey)
 	}
 
-	mm_params := OrdersRepositoryMockCreateParams{ctx, userID, items}
+	mm_params := OrdersRepositoryMockCreateParams{ctx, userID, items, idempotencyKey}
 
 	// Record call args
 	mmCreate.CreateMock.mutex.Lock()
@@ -308,7 +341,7 @@ func (mmCreate *OrdersRepositoryMock) Create(ctx context.Context, userID int64,
 		mm_want := mmCreate.CreateMock.defaultExpectation.params
 		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
 
-		mm_got := OrdersRepositoryMockCreateParams{ctx, userID, items}
+		mm_got := OrdersRepositoryMockCreateParams{ctx, userID, items, idempotencyKey}
 
 		if mm_want_ptrs != nil {
 
@@ -324,6 +357,10 @@ func (mmCreate *OrdersRepositoryMock) Create(ctx context.Context, userID int64,
 				mmCreate.t.Errorf("OrdersRepositoryMock.Create got unexpected parameter items, want: %#v, got: %#v%s\n", *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
 			}
 
+			if mm_want_ptrs.idempotencyKey != nil && !minimock.Equal(*mm_want_ptrs.idempotencyKey, mm_got.idempotencyKey) {
+				mmCreate.t.Errorf("OrdersRepositoryMock.Create got unexpected parameter idempotencyKey, want: %#v, got: %#v%s\n", *mm_want_ptrs.idempotencyKey, mm_got.idempotencyKey, minimock.Diff(*mm_want_ptrs.idempotencyKey, mm_got.idempotencyKey))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmCreate.t.Errorf("OrdersRepositoryMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -335,9 +372,9 @@ func (mmCreate *OrdersRepositoryMock) Create(ctx context.Context, userID int64,
 		return (*mm_results).i1, (*mm_results).err
 	}
 	if mmCreate.funcCreate != nil {
-		return mmCreate.funcCreate(ctx, userID, items)
+		return mmCreate.funcCreate(ctx, userID, items, idempotencyKey)
 	}
-	mmCreate.t.Fatalf("Unexpected call to OrdersRepositoryMock.Create. %v %v %v", ctx, userID, items)
+	mmCreate.t.Fatalf("Unexpected call to OrdersRepositoryMock.Create. %v %v %v %v", ctx, userID, items, idempotencyKey)
 	return
 }
 
@@ -730,6 +767,327 @@ func (m *OrdersRepositoryMock) MinimockGetByIDInspect() {
 	}
 }
 
+type mOrdersRepositoryMockList struct {
+	optional           bool
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockListExpectation
+	expectations       []*OrdersRepositoryMockListExpectation
+
+	callArgs []*OrdersRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// OrdersRepositoryMockListExpectation specifies expectation struct of the OrdersRepository.List
+type OrdersRepositoryMockListExpectation struct {
+	mock      *OrdersRepositoryMock
+	params    *OrdersRepositoryMockListParams
+	paramPtrs *OrdersRepositoryMockListParamPtrs
+	results   *OrdersRepositoryMockListResults
+	Counter   uint64
+}
+
+// OrdersRepositoryMockListParams contains parameters of the OrdersRepository.List
+type OrdersRepositoryMockListParams struct {
+	ctx    context.Context
+	filter domain.OrdersFilter
+}
+
+// OrdersRepositoryMockListParamPtrs contains pointers to parameters of the OrdersRepository.List
+type OrdersRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.OrdersFilter
+}
+
+// OrdersRepositoryMockListResults contains results of the OrdersRepository.List
+type OrdersRepositoryMockListResults struct {
+	oa1 []domain.Order
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option by default unless you really need it, as it helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mOrdersRepositoryMockList) Optional() *mOrdersRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for OrdersRepository.List
+func (mmList *mOrdersRepositoryMockList) Expect(ctx context.Context, filter domain.OrdersFilter) *mOrdersRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &OrdersRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &OrdersRepositoryMockListParams{ctx, filter}
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrdersRepository.List
+func (mmList *mOrdersRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mOrdersRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &OrdersRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &OrdersRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmList
+}
+
+// ExpectFilterParam2 sets up expected param filter for OrdersRepository.List
+func (mmList *mOrdersRepositoryMockList) ExpectFilterParam2(filter domain.OrdersFilter) *mOrdersRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &OrdersRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &OrdersRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.List
+func (mmList *mOrdersRepositoryMockList) Inspect(f func(ctx context.Context, filter domain.OrdersFilter)) *mOrdersRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by OrdersRepository.List
+func (mmList *mOrdersRepositoryMockList) Return(oa1 []domain.Order, err error) *OrdersRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &OrdersRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &OrdersRepositoryMockListResults{oa1, err}
+	return mmList.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.List method
+func (mmList *mOrdersRepositoryMockList) Set(f func(ctx context.Context, filter domain.OrdersFilter) (oa1 []domain.Order, err error)) *OrdersRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	return mmList.mock
+}
+
+// When sets expectation for the OrdersRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mOrdersRepositoryMockList) When(ctx context.Context, filter domain.OrdersFilter) *OrdersRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("OrdersRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockListExpectation{
+		mock:   mmList.mock,
+		params: &OrdersRepositoryMockListParams{ctx, filter},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.List return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockListExpectation) Then(oa1 []domain.Order, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockListResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times OrdersRepository.List should be invoked
+func (mmList *mOrdersRepositoryMockList) Times(n uint64) *mOrdersRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of OrdersRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	return mmList
+}
+
+func (mmList *mOrdersRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements lomsusecase.OrdersRepository
+func (mmList *OrdersRepositoryMock) List(ctx context.Context, filter domain.OrdersFilter) (oa1 []domain.Order, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, filter)
+	}
+
+	mm_params := OrdersRepositoryMockListParams{ctx, filter}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := OrdersRepositoryMockListParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("OrdersRepositoryMock.List got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("OrdersRepositoryMock.List got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("OrdersRepositoryMock.List got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the OrdersRepositoryMock.List")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, filter)
+	}
+	mmList.t.Fatalf("Unexpected call to OrdersRepositoryMock.List. %v %v", ctx, filter)
+	return
+}
+
+// ListAfterCounter returns a count of finished OrdersRepositoryMock.List invocations
+func (mmList *OrdersRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of OrdersRepositoryMock.List invocations
+func (mmList *OrdersRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mOrdersRepositoryMockList) Calls() []*OrdersRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.List with params: %#v", *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.List")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.List with params: %#v", *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.List")
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersRepositoryMock.List but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), afterListCounter)
+	}
+}
+
 type mOrdersRepositoryMockSetStatus struct {
 	optional           bool
 	mock               *OrdersRepositoryMock
@@ -1086,6 +1444,8 @@ func (m *OrdersRepositoryMock) MinimockFinish() {
 
 			m.MinimockGetByIDInspect()
 
+			m.MinimockListInspect()
+
 			m.MinimockSetStatusInspect()
 			m.t.FailNow()
 		}
@@ -1113,5 +1473,6 @@ func (m *OrdersRepositoryMock) minimockDone() bool {
 	return done &&
 		m.MinimockCreateDone() &&
 		m.MinimockGetByIDDone() &&
+		m.MinimockListDone() &&
 		m.MinimockSetStatusDone()
 }
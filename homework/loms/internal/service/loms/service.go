@@ -8,9 +8,10 @@ import (
 
 type (
 	OrdersRepository interface {
-		Create(_ context.Context, userID int64, items []domain.Item) (int64, error)
+		Create(_ context.Context, userID int64, items []domain.Item, idempotencyKey string) (int64, error)
 		SetStatus(_ context.Context, orderID int64, status string) error
 		GetByID(_ context.Context, orderID int64) (*domain.Order, error)
+		List(_ context.Context, filter domain.OrdersFilter) ([]domain.Order, error)
 	}
 	StocksRepository interface {
 		Reserve(_ context.Context, items []domain.Item) error
@@ -18,16 +19,22 @@ type (
 		ReserveCancel(_ context.Context, items []domain.Item) error
 		GetBySKU(_ context.Context, sku uint32) (*int64, error)
 	}
+	// TxManager runs fn as a unit of work, the repositories called with the ctx passed to fn share one transaction
+	TxManager interface {
+		RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+	}
 
 	Service struct {
 		ordersRepo OrdersRepository
 		stocksRepo StocksRepository
+		txManager  TxManager
 	}
 )
 
-func NewService(ordersRepo OrdersRepository, stocksRepo StocksRepository) *Service {
+func NewService(ordersRepo OrdersRepository, stocksRepo StocksRepository, txManager TxManager) *Service {
 	return &Service{
 		ordersRepo: ordersRepo,
 		stocksRepo: stocksRepo,
+		txManager:  txManager,
 	}
 }
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+ADD COLUMN created_at timestamp with time zone not null default now();
+
+CREATE INDEX orders_status_created_at_idx ON orders (status, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_status_created_at_idx;
+
+ALTER TABLE orders DROP COLUMN created_at;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+ADD COLUMN idempotency_key varchar;
+
+CREATE UNIQUE INDEX orders_idempotency_key_idx ON orders (idempotency_key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_idempotency_key_idx;
+
+ALTER TABLE orders DROP COLUMN idempotency_key;
+-- +goose StatementEnd
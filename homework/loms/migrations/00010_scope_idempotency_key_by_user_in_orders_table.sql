@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_idempotency_key_idx;
+
+CREATE UNIQUE INDEX orders_user_id_idempotency_key_idx ON orders (user_id, idempotency_key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_user_id_idempotency_key_idx;
+
+CREATE UNIQUE INDEX orders_idempotency_key_idx ON orders (idempotency_key);
+-- +goose StatementEnd
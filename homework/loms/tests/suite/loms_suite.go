@@ -2,10 +2,13 @@ package lomssuite
 
 import (
 	"context"
+	"errors"
 	"log"
 	"os"
+	"sync"
+	"time"
 
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -14,14 +17,19 @@ import (
 	"route256/loms/internal/domain"
 	"route256/loms/internal/repository/db/orders"
 	"route256/loms/internal/repository/db/stocks"
+	"route256/loms/internal/repository/db/transaction"
+	lomsusecase "route256/loms/internal/service/loms"
 )
 
+const dbConnEnv = "DB_CONN_TEST"
+
 type ItemS struct {
 	suite.Suite
 	stocksStorage *stocks.Storage
 	ordersStorage *orders.Storage
+	txManager     *transaction.Manager
 	ctx           context.Context
-	conn          *pgx.Conn
+	pool          *pgxpool.Pool
 }
 
 func (s *ItemS) SetupSuite() {
@@ -29,23 +37,27 @@ func (s *ItemS) SetupSuite() {
 
 	ctx := context.Background()
 
-	const dbConnEnv = "DB_CONN_TEST"
 	dbConnStr := os.Getenv(dbConnEnv)
-	conn, err := pgx.Connect(ctx, dbConnStr)
+	pool, err := pgxpool.New(ctx, dbConnStr)
 
 	if err != nil {
 		s.T().Fatal(err)
 	}
 
 	s.ctx = ctx
-	s.ordersStorage = orders.NewStorage(conn, conn)
-	s.stocksStorage = stocks.NewStorage(conn, conn)
-	s.conn = conn
+	s.ordersStorage = orders.NewStorage(pool, pool)
+	s.stocksStorage = stocks.NewStorage(pool, pool)
+	s.txManager = transaction.NewManager(pool)
+	s.pool = pool
+}
+
+func (s *ItemS) TearDownSuite() {
+	s.pool.Close()
 }
 
 func (s *ItemS) SetupTest() {
 	// To fill in the database from the stock-data.json file
-	err := stocks.FillStocks(stocks.New(s.conn))
+	err := stocks.FillStocks(stocks.New(s.pool))
 	if err != nil {
 		s.T().Fatal(err)
 	}
@@ -53,9 +65,9 @@ func (s *ItemS) SetupTest() {
 
 func (s *ItemS) TearDownTest() {
 	const query = `
-	TRUNCATE TABLE orders, order_items, stocks;`
+	TRUNCATE TABLE orders, order_items, outbox_order_events, stocks;`
 
-	_, err := s.conn.Exec(s.ctx, query)
+	_, err := s.pool.Exec(s.ctx, query)
 	if err != nil {
 		s.T().Fatal(err)
 	}
@@ -63,32 +75,123 @@ func (s *ItemS) TearDownTest() {
 
 func (s *ItemS) TestReserveStocksDB() {
 	items := []domain.Item{{
-		SKU:   872821,
+		SKU:   1076963,
 		Count: 8,
 	}}
 
 	err := s.stocksStorage.Reserve(s.ctx, items)
 	require.NoError(s.T(), err)
+
+	available, err := s.stocksStorage.GetBySKU(s.ctx, 1076963)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(22), *available)
 }
 
-func (s *ItemS) TestReserveRemoveStocksDB() {
+func (s *ItemS) TestReserveInsufficientStocksDB() {
+	items := []domain.Item{{
+		SKU:   1076963,
+		Count: 8,
+	}, {
+		SKU:   1148162,
+		Count: 19,
+	}}
+
+	err := s.stocksStorage.Reserve(s.ctx, items)
+	require.ErrorIs(s.T(), err, domain.InsufficientStocksError{SKU: 1148162})
+	require.EqualError(s.T(), err, "insufficient stocks for sku 1148162")
+
+	available, err := s.stocksStorage.GetBySKU(s.ctx, 1076963)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(30), *available)
+}
+
+func (s *ItemS) TestReserveUnknownStockDB() {
 	items := []domain.Item{{
 		SKU:   872821,
 		Count: 8,
 	}}
 
-	err := s.stocksStorage.ReserveRemove(s.ctx, items)
+	err := s.stocksStorage.Reserve(s.ctx, items)
+	require.ErrorIs(s.T(), err, stocks.StockNotFoundError{})
+}
+
+func (s *ItemS) TestReserveRemoveStocksDB() {
+	items := []domain.Item{{
+		SKU:   1076963,
+		Count: 8,
+	}}
+
+	err := s.stocksStorage.Reserve(s.ctx, items)
 	require.NoError(s.T(), err)
+
+	err = s.stocksStorage.ReserveRemove(s.ctx, items)
+	require.NoError(s.T(), err)
+
+	err = s.stocksStorage.ReserveRemove(s.ctx, items)
+	require.ErrorIs(s.T(), err, domain.InsufficientStocksError{SKU: 1076963})
+
+	available, err := s.stocksStorage.GetBySKU(s.ctx, 1076963)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(22), *available)
 }
 
 func (s *ItemS) TestReserveCancelStocksDB() {
 	items := []domain.Item{{
-		SKU:   872821,
+		SKU:   1076963,
 		Count: 8,
 	}}
 
-	err := s.stocksStorage.ReserveCancel(s.ctx, items)
+	err := s.stocksStorage.Reserve(s.ctx, items)
 	require.NoError(s.T(), err)
+
+	err = s.stocksStorage.ReserveCancel(s.ctx, items)
+	require.NoError(s.T(), err)
+
+	err = s.stocksStorage.ReserveCancel(s.ctx, items)
+	require.ErrorIs(s.T(), err, domain.InsufficientStocksError{SKU: 1076963})
+
+	available, err := s.stocksStorage.GetBySKU(s.ctx, 1076963)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(30), *available)
+}
+
+func (s *ItemS) TestReserveConcurrentOrdersDB() {
+	const (
+		sku        = 1148162
+		totalCount = 18
+		orders     = 30
+	)
+
+	errs := make([]error, orders)
+
+	wg := sync.WaitGroup{}
+	wg.Add(orders)
+
+	for i := 0; i < orders; i++ {
+		go func() {
+			defer wg.Done()
+
+			errs[i] = s.stocksStorage.Reserve(s.ctx, []domain.Item{{SKU: sku, Count: 1}})
+		}()
+	}
+
+	wg.Wait()
+
+	reserved := 0
+	for _, err := range errs {
+		if err == nil {
+			reserved++
+			continue
+		}
+
+		require.ErrorIs(s.T(), err, domain.InsufficientStocksError{SKU: sku})
+	}
+
+	require.Equal(s.T(), totalCount, reserved)
+
+	available, err := s.stocksStorage.GetBySKU(s.ctx, sku)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(0), *available)
 }
 
 func (s *ItemS) TestGetStockBySKUDB() {
@@ -106,15 +209,78 @@ func (s *ItemS) TestCreateOrderDB() {
 		Count: 8,
 	}}
 
-	_, err := s.ordersStorage.Create(s.ctx, userID, items)
+	_, err := s.ordersStorage.Create(s.ctx, userID, items, "")
+	require.NoError(s.T(), err)
+}
+
+func (s *ItemS) TestCreateOrderIdempotencyKeyDB() {
+	var userID int64 = 727
+
+	items := []domain.Item{{
+		SKU:   872821,
+		Count: 8,
+	}}
+
+	orderID, err := s.ordersStorage.Create(s.ctx, userID, items, "checkout-727")
+	require.NoError(s.T(), err)
+
+	_, err = s.ordersStorage.Create(s.ctx, userID, items, "checkout-727")
+	require.ErrorIs(s.T(), err, domain.OrderExistsError{OrderID: orderID, Status: orderStatus.New})
+
+	// Заказы без ключа не дедуплицируются
+	_, err = s.ordersStorage.Create(s.ctx, userID, items, "")
+	require.NoError(s.T(), err)
+
+	_, err = s.ordersStorage.Create(s.ctx, userID, items, "")
+	require.NoError(s.T(), err)
+}
+
+func (s *ItemS) TestCreateOrderIdempotencyKeyIsScopedByUserDB() {
+	items := []domain.Item{{
+		SKU:   872821,
+		Count: 8,
+	}}
+
+	firstOrderID, err := s.ordersStorage.Create(s.ctx, 727, items, "1")
 	require.NoError(s.T(), err)
+
+	// Тот же ключ другого пользователя создаёт его собственный заказ
+	secondOrderID, err := s.ordersStorage.Create(s.ctx, 728, items, "1")
+	require.NoError(s.T(), err)
+	require.NotEqual(s.T(), firstOrderID, secondOrderID)
+
+	_, err = s.ordersStorage.Create(s.ctx, 728, items, "1")
+	require.ErrorIs(s.T(), err, domain.OrderExistsError{OrderID: secondOrderID, Status: orderStatus.New})
 }
 
 func (s *ItemS) TestSetOrderStatusDB() {
-	var orderID int64 = 231
+	var userID int64 = 727
+
+	items := []domain.Item{{
+		SKU:   872821,
+		Count: 8,
+	}}
+
+	orderID, err := s.ordersStorage.Create(s.ctx, userID, items, "")
+	require.NoError(s.T(), err)
 
-	err := s.ordersStorage.SetStatus(s.ctx, orderID, orderStatus.AwaitingPayment)
+	err = s.ordersStorage.SetStatus(s.ctx, orderID, orderStatus.AwaitingPayment)
 	require.NoError(s.T(), err)
+
+	err = s.ordersStorage.SetStatus(s.ctx, orderID, orderStatus.Cancelled)
+	require.NoError(s.T(), err)
+
+	err = s.ordersStorage.SetStatus(s.ctx, orderID, orderStatus.Payed)
+	require.ErrorIs(s.T(), err, domain.OrderStatusTransitionError{})
+
+	order, err := s.ordersStorage.GetByID(s.ctx, orderID)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), orderStatus.Cancelled, order.Status)
+}
+
+func (s *ItemS) TestSetUnknownOrderStatusDB() {
+	err := s.ordersStorage.SetStatus(s.ctx, 231, orderStatus.AwaitingPayment)
+	require.ErrorIs(s.T(), err, orders.OrderNotFoundError{})
 }
 
 func (s *ItemS) TestGetOrderByIDDB() {
@@ -125,13 +291,178 @@ func (s *ItemS) TestGetOrderByIDDB() {
 		Count: 8,
 	}}
 
-	orderID, err := s.ordersStorage.Create(s.ctx, userID, items)
+	orderID, err := s.ordersStorage.Create(s.ctx, userID, items, "")
 	require.NoError(s.T(), err)
 
 	_, err = s.ordersStorage.GetByID(s.ctx, orderID)
 	require.NoError(s.T(), err)
 }
 
+func (s *ItemS) TestCreateOrderInsufficientStocksDB() {
+	service := lomsusecase.NewService(s.ordersStorage, s.stocksStorage, s.txManager)
+
+	items := []domain.Item{{
+		SKU:   1076963,
+		Count: 8,
+	}, {
+		SKU:   1148162,
+		Count: 19,
+	}}
+
+	_, err := service.CreateOrder(s.ctx, 727, items, "")
+	require.ErrorIs(s.T(), err, domain.InsufficientStocksError{SKU: 1148162})
+
+	var status string
+	err = s.pool.QueryRow(s.ctx, "SELECT status FROM orders WHERE user_id = $1", 727).Scan(&status)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), orderStatus.Failed, status)
+
+	available, err := s.stocksStorage.GetBySKU(s.ctx, 1076963)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(30), *available)
+}
+
+func (s *ItemS) TestCreateOrderConcurrentTxDB() {
+	const (
+		sku    = 1076963
+		orders = 10
+	)
+
+	service := lomsusecase.NewService(s.ordersStorage, s.stocksStorage, s.txManager)
+
+	errs := make([]error, orders)
+
+	wg := sync.WaitGroup{}
+	wg.Add(orders)
+
+	// Транзакции параллельных заказов идут на разных соединениях пула и не мешают друг другу
+	for i := 0; i < orders; i++ {
+		go func() {
+			defer wg.Done()
+
+			_, errs[i] = service.CreateOrder(s.ctx, 729, []domain.Item{{SKU: sku, Count: 1}}, "")
+		}()
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(s.T(), err)
+	}
+
+	var created int
+	err := s.pool.QueryRow(s.ctx, "SELECT count(*) FROM orders WHERE user_id = $1 AND status = $2", 729, orderStatus.AwaitingPayment).Scan(&created)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), orders, created)
+
+	available, err := s.stocksStorage.GetBySKU(s.ctx, sku)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(30-orders), *available)
+}
+
+func (s *ItemS) TestRunInTxRollbackDB() {
+	items := []domain.Item{{
+		SKU:   1076963,
+		Count: 8,
+	}}
+
+	errRollback := errors.New("rollback")
+
+	err := s.txManager.RunInTx(s.ctx, func(ctx context.Context) error {
+		orderID, err := s.ordersStorage.Create(ctx, 728, items, "")
+		require.NoError(s.T(), err)
+
+		err = s.stocksStorage.Reserve(ctx, items)
+		require.NoError(s.T(), err)
+
+		err = s.ordersStorage.SetStatus(ctx, orderID, orderStatus.AwaitingPayment)
+		require.NoError(s.T(), err)
+
+		return errRollback
+	})
+	require.ErrorIs(s.T(), err, errRollback)
+
+	var orders int
+	err = s.pool.QueryRow(s.ctx, "SELECT count(*) FROM orders WHERE user_id = $1", 728).Scan(&orders)
+	require.NoError(s.T(), err)
+	require.Zero(s.T(), orders)
+
+	available, err := s.stocksStorage.GetBySKU(s.ctx, 1076963)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(30), *available)
+}
+
+func (s *ItemS) TestGetUnpaidOrderIDsDB() {
+	items := []domain.Item{{
+		SKU:   872821,
+		Count: 8,
+	}}
+
+	orderID, err := s.ordersStorage.Create(s.ctx, 727, items, "")
+	require.NoError(s.T(), err)
+
+	err = s.ordersStorage.SetStatus(s.ctx, orderID, orderStatus.AwaitingPayment)
+	require.NoError(s.T(), err)
+
+	ids, err := s.ordersStorage.GetIDsByStatus(s.ctx, orderStatus.AwaitingPayment, time.Now().Add(-time.Minute), 0, 10)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), ids)
+
+	ids, err = s.ordersStorage.GetIDsByStatus(s.ctx, orderStatus.AwaitingPayment, time.Now().Add(time.Minute), 0, 10)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []int64{orderID}, ids)
+
+	ids, err = s.ordersStorage.GetIDsByStatus(s.ctx, orderStatus.AwaitingPayment, time.Now().Add(time.Minute), orderID, 10)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), ids)
+}
+
+func (s *ItemS) TestListOrdersDB() {
+	service := lomsusecase.NewService(s.ordersStorage, s.stocksStorage, s.txManager)
+
+	items := []domain.Item{{
+		SKU:   872821,
+		Count: 8,
+	}}
+
+	orderIDs := make([]int64, 3)
+	for i := range orderIDs {
+		orderID, err := s.ordersStorage.Create(s.ctx, 730, items, "")
+		require.NoError(s.T(), err)
+
+		orderIDs[i] = orderID
+	}
+
+	_, err := s.ordersStorage.Create(s.ctx, 731, items, "")
+	require.NoError(s.T(), err)
+
+	err = s.ordersStorage.SetStatus(s.ctx, orderIDs[1], orderStatus.AwaitingPayment)
+	require.NoError(s.T(), err)
+
+	filter := domain.OrdersFilter{UserID: 730}
+
+	firstPage, nextPageToken, err := service.ListOrders(s.ctx, filter, "", 2)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), nextPageToken)
+	require.Len(s.T(), firstPage, 2)
+	require.Equal(s.T(), orderIDs[2], firstPage[0].ID)
+	require.Equal(s.T(), orderIDs[1], firstPage[1].ID)
+	require.Equal(s.T(), items[0].SKU, firstPage[0].Items[0].SKU)
+
+	secondPage, nextPageToken, err := service.ListOrders(s.ctx, filter, nextPageToken, 2)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), nextPageToken)
+	require.Len(s.T(), secondPage, 1)
+	require.Equal(s.T(), orderIDs[0], secondPage[0].ID)
+
+	filter.Statuses = []string{orderStatus.AwaitingPayment}
+
+	awaitingPayment, _, err := service.ListOrders(s.ctx, filter, "", 2)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), awaitingPayment, 1)
+	require.Equal(s.T(), orderIDs[1], awaitingPayment[0].ID)
+}
+
 func initEnv() {
 	err := godotenv.Load("../../.env")
 